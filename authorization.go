@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"context"
+)
+
+type Authorizer interface {
+	Authorize(permissions []string) bool
+}
+
+type AuthorizerFunc func(permissions []string) bool
+
+func (f AuthorizerFunc) Authorize(permissions []string) bool {
+	return f(permissions)
+}
+
+type LinkPolicy int
+
+const (
+	OmitUnauthorizedLinks LinkPolicy = iota
+	DisableUnauthorizedLinks
+)
+
+type authorizerContextKey struct{}
+
+type contextAuthorizer struct {
+	authorizer Authorizer
+	policy     LinkPolicy
+}
+
+func WithAuthorizer(ctx context.Context, authorizer Authorizer, policy LinkPolicy) context.Context {
+	return context.WithValue(ctx, authorizerContextKey{}, contextAuthorizer{authorizer, policy})
+}
+
+func AuthorizerFromContext(ctx context.Context) (Authorizer, LinkPolicy, bool) {
+	ca, ok := ctx.Value(authorizerContextKey{}).(contextAuthorizer)
+	return ca.authorizer, ca.policy, ok
+}
+
+func HasPermissions(granted []string) Authorizer {
+	return AuthorizerFunc(func(permissions []string) bool {
+		for _, permission := range permissions {
+			found := false
+			for _, g := range granted {
+				if g == permission {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) Authorize(authorizer Authorizer, policy LinkPolicy) *Resource {
+	*r = authorizeResource(*r, authorizer, policy)
+	return r
+}
+
+func authorizeResource(r Resource, authorizer Authorizer, policy LinkPolicy) Resource {
+	if len(r.Links) > 0 {
+		links := make(LinkData)
+		for name, link := range r.Links {
+			if len(link.Permissions) == 0 || authorizer.Authorize(link.Permissions) {
+				links[name] = link
+				continue
+			}
+
+			if policy == DisableUnauthorizedLinks {
+				disabled := *link
+				disabled.Disabled = true
+				links[name] = &disabled
+			}
+		}
+		r.Links = links
+	}
+
+	if len(r.Embedded) > 0 {
+		embedded := make(EmbeddedResources)
+		for name, value := range r.Embedded {
+			if embeddedResource, ok := value.(Resource); ok {
+				embedded[name] = authorizeResource(embeddedResource, authorizer, policy)
+			} else if embeddedResourceList, ok := value.([]Resource); ok {
+				authorized := make([]Resource, len(embeddedResourceList))
+				for i, embeddedResource := range embeddedResourceList {
+					authorized[i] = authorizeResource(embeddedResource, authorizer, policy)
+				}
+				embedded[name] = authorized
+			} else {
+				embedded[name] = value
+			}
+		}
+		r.Embedded = embedded
+	}
+
+	return r
+}
@@ -0,0 +1,91 @@
+package resource
+
+import (
+	"context"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newAuthorizationTestResource() Resource {
+	r := NewResource("User")
+	r.Link("self", "/user/1")
+	r.Link("updateUser", "/user/1", option.Verb("PUT"), option.Requires("user:write"))
+	r.Link("deleteUser", "/user/1", option.Verb("DELETE")).Requires("user:write", "user:delete")
+	return r
+}
+
+func Test_RequiresMustSetPermissionsOnLink(t *testing.T) {
+	//act
+	r := newAuthorizationTestResource()
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]string{}, r.Links["self"].Permissions)
+	a.Equal([]string{"user:write"}, r.Links["updateUser"].Permissions)
+	a.Equal([]string{"user:write", "user:delete"}, r.Links["deleteUser"].Permissions)
+}
+
+func Test_AuthorizeMustOmitUnauthorizedLinks(t *testing.T) {
+	//arrange
+	r := newAuthorizationTestResource()
+
+	//act
+	r.Authorize(HasPermissions([]string{"user:write"}), OmitUnauthorizedLinks)
+
+	//assert
+	a := assert.New(t)
+	a.Contains(r.Links, "self")
+	a.Contains(r.Links, "updateUser")
+	a.NotContains(r.Links, "deleteUser")
+}
+
+func Test_AuthorizeMustDisableUnauthorizedLinks(t *testing.T) {
+	//arrange
+	r := newAuthorizationTestResource()
+	original := r.Links["deleteUser"]
+
+	//act
+	r.Authorize(HasPermissions(nil), DisableUnauthorizedLinks)
+
+	//assert
+	a := assert.New(t)
+	a.False(r.Links["self"].Disabled)
+	a.True(r.Links["updateUser"].Disabled)
+	a.True(r.Links["deleteUser"].Disabled)
+	a.False(original.Disabled)
+}
+
+func Test_AuthorizeMustApplyToEmbeddedResources(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+	r.EmbedResources("users", []Resource{newAuthorizationTestResource(), newAuthorizationTestResource()})
+	r.EmbedResource("owner", newAuthorizationTestResource())
+
+	//act
+	r.Authorize(AuthorizerFunc(func([]string) bool { return false }), OmitUnauthorizedLinks)
+
+	//assert
+	a := assert.New(t)
+	for _, user := range r.Embedded["users"].([]Resource) {
+		a.Len(user.Links, 1)
+	}
+	a.Len(r.Embedded["owner"].(Resource).Links, 1)
+}
+
+func Test_AuthorizerFromContextMustReturnAuthorizerAddedToContext(t *testing.T) {
+	//arrange
+	ctx := WithAuthorizer(context.Background(), HasPermissions([]string{"admin"}), DisableUnauthorizedLinks)
+
+	//act
+	authorizer, policy, ok := AuthorizerFromContext(ctx)
+	_, _, missing := AuthorizerFromContext(context.Background())
+
+	//assert
+	a := assert.New(t)
+	a.True(ok)
+	a.False(missing)
+	a.Equal(DisableUnauthorizedLinks, policy)
+	a.True(authorizer.Authorize([]string{"admin"}))
+	a.False(authorizer.Authorize([]string{"admin", "root"}))
+}
@@ -0,0 +1,297 @@
+package client
+
+import (
+	"container/list"
+	"github.com/slyjeff/rest-resource"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CacheEntry struct {
+	Resource     resource.Resource
+	ETag         string
+	LastModified string
+	Expires      time.Time
+	Vary         map[string]string
+}
+
+func (e CacheEntry) isFresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+func (e CacheEntry) matches(req *http.Request) bool {
+	for name, value := range e.Vary {
+		if req.Header.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+type CacheStore interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+	Delete(key string)
+}
+
+func (c *Client) doCached(req *http.Request, linkName string, link resource.Link) (resource.Resource, error) {
+	key := cacheKey(req)
+
+	entry, cached := c.cache.Get(key)
+	if cached && !entry.matches(req) {
+		cached = false
+	}
+
+	if cached {
+		if entry.isFresh(time.Now()) {
+			return copyResource(entry.Resource), nil
+		}
+
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+	defer res.Body.Close()
+
+	if cached && res.StatusCode == http.StatusNotModified {
+		entry.Expires = findExpiration(res.Header, time.Now())
+		if etag := res.Header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		c.cache.Set(key, entry)
+		return copyResource(entry.Resource), nil
+	}
+
+	r, err := decodeResponse(linkName, link, res)
+	if err != nil {
+		return r, err
+	}
+
+	if res.StatusCode == http.StatusOK {
+		c.store(key, req, res, r)
+	}
+
+	return r, nil
+}
+
+func (c *Client) store(key string, req *http.Request, res *http.Response, r resource.Resource) {
+	directives := parseCacheControl(res.Header)
+	if _, noStore := directives["no-store"]; noStore {
+		c.cache.Delete(key)
+		return
+	}
+
+	vary := make(map[string]string)
+	for _, value := range res.Header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "*" {
+				c.cache.Delete(key)
+				return
+			}
+			if name != "" {
+				vary[name] = req.Header.Get(name)
+			}
+		}
+	}
+
+	entry := CacheEntry{
+		copyResource(r),
+		res.Header.Get("ETag"),
+		res.Header.Get("Last-Modified"),
+		findExpiration(res.Header, time.Now()),
+		vary,
+	}
+
+	if entry.ETag == "" && entry.LastModified == "" && !entry.isFresh(time.Now()) {
+		return
+	}
+
+	c.cache.Set(key, entry)
+}
+
+func copyResource(r resource.Resource) resource.Resource {
+	copied := resource.Resource{Schema: r.Schema}
+
+	if r.Values != nil {
+		copied.Values = copyValue(r.Values).(resource.MappedData)
+	}
+
+	if r.Links != nil {
+		copied.Links = make(resource.LinkData, len(r.Links))
+		for name, link := range r.Links {
+			linkCopy := *link
+			linkCopy.Parameters = append([]resource.LinkParameter(nil), link.Parameters...)
+			linkCopy.ResponseCodes = append([]int(nil), link.ResponseCodes...)
+			linkCopy.Permissions = append([]string(nil), link.Permissions...)
+			copied.Links[name] = &linkCopy
+		}
+	}
+
+	if r.Embedded != nil {
+		copied.Embedded = make(resource.EmbeddedResources, len(r.Embedded))
+		for name, value := range r.Embedded {
+			if embeddedResource, ok := value.(resource.Resource); ok {
+				copied.Embedded[name] = copyResource(embeddedResource)
+			} else if embeddedResourceList, ok := value.([]resource.Resource); ok {
+				copiedList := make([]resource.Resource, len(embeddedResourceList))
+				for i, embeddedResource := range embeddedResourceList {
+					copiedList[i] = copyResource(embeddedResource)
+				}
+				copied.Embedded[name] = copiedList
+			} else {
+				copied.Embedded[name] = value
+			}
+		}
+	}
+
+	return copied
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case resource.MappedData:
+		copied := make(resource.MappedData, len(v))
+		for name, item := range v {
+			copied[name] = copyValue(item)
+		}
+		return copied
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for name, item := range v {
+			copied[name] = copyValue(item)
+		}
+		return copied
+	case []resource.MappedData:
+		copied := make([]resource.MappedData, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item).(resource.MappedData)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+func cacheKey(req *http.Request) string {
+	return req.URL.String() + " " + req.Header.Get("Accept")
+}
+
+func findExpiration(headers http.Header, now time.Time) time.Time {
+	directives := parseCacheControl(headers)
+
+	if _, noCache := directives["no-cache"]; noCache {
+		return now
+	}
+
+	if maxAge, ok := directives["max-age"]; ok {
+		if seconds, err := strconv.Atoi(maxAge); err == nil {
+			return now.Add(time.Duration(seconds) * time.Second)
+		}
+		return now
+	}
+
+	if expires := headers.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil {
+			return t
+		}
+	}
+
+	return now
+}
+
+func parseCacheControl(headers http.Header) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range headers.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, argument, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if name == "" {
+				continue
+			}
+			directives[strings.ToLower(name)] = strings.Trim(argument, "\"")
+		}
+	}
+	return directives
+}
+
+type LRUStore struct {
+	capacity int
+	mutex    sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type lruItem struct {
+	key   string
+	entry CacheEntry
+}
+
+func NewLRUStore(capacity int) *LRUStore {
+	return &LRUStore{capacity: capacity, entries: make(map[string]*list.Element), order: list.New()}
+}
+
+func (s *LRUStore) Get(key string) (CacheEntry, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+
+	s.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+func (s *LRUStore) Set(key string, entry CacheEntry) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		s.order.MoveToFront(element)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(&lruItem{key, entry})
+
+	for s.capacity > 0 && s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (s *LRUStore) Delete(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.order.Remove(element)
+		delete(s.entries, key)
+	}
+}
+
+func (s *LRUStore) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.order.Len()
+}
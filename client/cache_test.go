@@ -0,0 +1,233 @@
+package client
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type cacheTestServer struct {
+	server       *httptest.Server
+	requests     []*http.Request
+	cacheControl string
+	vary         string
+	version      int
+}
+
+func newCacheTestServer(cacheControl string) *cacheTestServer {
+	s := &cacheTestServer{cacheControl: cacheControl, version: 1}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.requests = append(s.requests, req)
+
+		r := resource.NewResource("User")
+		r.Uri("/user/1")
+		r.Data("version", s.version)
+		r.Link("updateUser", "/user/1", option.Verb("PUT"))
+
+		etag := `"v` + strconv.Itoa(s.version) + `"`
+		if req.Method == http.MethodGet && req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		if s.cacheControl != "" {
+			w.Header().Set("Cache-Control", s.cacheControl)
+		}
+		if s.vary != "" {
+			w.Header().Set("Vary", s.vary)
+		}
+		_ = encoding.Write(w, req, http.StatusOK, r, option.ETag(etag))
+	}))
+	return s
+}
+
+func Test_CacheMustReturnFreshResourceWithoutRequest(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("max-age=60")
+	defer s.server.Close()
+	c := New(s.server.URL + "/user/1").UseCache(NewLRUStore(10))
+	_, _ = c.Get(s.server.URL + "/user/1")
+
+	//act
+	r, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(float64(1), r.Values["version"])
+	a.Len(s.requests, 1)
+}
+
+func Test_CacheMustNotShareResourcesWithCaller(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("max-age=60")
+	defer s.server.Close()
+	c := New(s.server.URL + "/user/1").UseCache(NewLRUStore(10))
+	first, _ := c.Get(s.server.URL + "/user/1")
+	first.Values["version"] = float64(99)
+	first.Links["updateUser"].Href = "/changed"
+
+	//act
+	second, err := c.Get(s.server.URL + "/user/1")
+	second.Values["version"] = float64(100)
+	third, _ := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(float64(1), third.Values["version"])
+	a.Equal("/user/1", third.Links["updateUser"].Href)
+	a.Len(s.requests, 1)
+}
+
+func Test_CacheMustRevalidateStaleResourceWithETag(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("no-cache")
+	defer s.server.Close()
+	c := New(s.server.URL + "/user/1").UseCache(NewLRUStore(10))
+	_, _ = c.Get(s.server.URL + "/user/1")
+
+	//act
+	r, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(float64(1), r.Values["version"])
+	a.Len(s.requests, 2)
+	a.Equal(`"v1"`, s.requests[1].Header.Get("If-None-Match"))
+}
+
+func Test_CacheMustReplaceResourceWhenChanged(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("no-cache")
+	defer s.server.Close()
+	c := New(s.server.URL + "/user/1").UseCache(NewLRUStore(10))
+	_, _ = c.Get(s.server.URL + "/user/1")
+	s.version = 2
+
+	//act
+	r, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(float64(2), r.Values["version"])
+}
+
+func Test_CacheMustNotStoreWithNoStore(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("no-store")
+	defer s.server.Close()
+	store := NewLRUStore(10)
+	c := New(s.server.URL + "/user/1").UseCache(store)
+
+	//act
+	_, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(0, store.Len())
+}
+
+func Test_CacheMustNotStoreWithVaryAll(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("max-age=60")
+	s.vary = "*"
+	defer s.server.Close()
+	store := NewLRUStore(10)
+	c := New(s.server.URL + "/user/1").UseCache(store)
+
+	//act
+	_, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(0, store.Len())
+}
+
+func Test_CacheMustInvalidateAfterUnsafeRequest(t *testing.T) {
+	//arrange
+	s := newCacheTestServer("max-age=60")
+	defer s.server.Close()
+	c := New(s.server.URL + "/user/1").UseCache(NewLRUStore(10))
+	u, _ := c.Get(s.server.URL + "/user/1")
+	_, _ = c.FollowFrom(u, "updateUser").Do()
+
+	//act
+	_, err := c.Get(s.server.URL + "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Len(s.requests, 3)
+}
+
+func Test_CacheEntryMustNotMatchRequestWithDifferentVaryHeader(t *testing.T) {
+	//arrange
+	entry := CacheEntry{Vary: map[string]string{"Accept-Language": "en"}}
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("Accept-Language", "fr")
+
+	//act
+	matches := entry.matches(req)
+
+	//assert
+	a := assert.New(t)
+	a.False(matches)
+}
+
+func Test_FindExpirationMustUseMaxAge(t *testing.T) {
+	//arrange
+	now := time.Now()
+	headers := http.Header{"Cache-Control": {"public, max-age=30"}}
+
+	//act
+	expires := findExpiration(headers, now)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(now.Add(30*time.Second), expires)
+}
+
+func Test_FindExpirationMustUseExpiresHeader(t *testing.T) {
+	//arrange
+	now := time.Now()
+	expiresAt := now.Add(time.Hour).UTC().Truncate(time.Second)
+	headers := http.Header{"Expires": {expiresAt.Format(http.TimeFormat)}}
+
+	//act
+	expires := findExpiration(headers, now)
+
+	//assert
+	a := assert.New(t)
+	a.True(expiresAt.Equal(expires))
+}
+
+func Test_LRUStoreMustEvictLeastRecentlyUsed(t *testing.T) {
+	//arrange
+	store := NewLRUStore(2)
+	store.Set("a", CacheEntry{ETag: "a"})
+	store.Set("b", CacheEntry{ETag: "b"})
+	store.Get("a")
+
+	//act
+	store.Set("c", CacheEntry{ETag: "c"})
+
+	//assert
+	a := assert.New(t)
+	_, ok := store.Get("b")
+	a.False(ok)
+	_, ok = store.Get("a")
+	a.True(ok)
+	_, ok = store.Get("c")
+	a.True(ok)
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+var ErrLinkNotFound = errors.New("link not found")
+
+type Client struct {
+	rootUri    string
+	httpClient *http.Client
+	root       *resource.Resource
+	rootMutex  sync.Mutex
+	cache      CacheStore
+}
+
+func New(rootUri string, httpClient ...*http.Client) *Client {
+	c := http.DefaultClient
+	if len(httpClient) > 0 {
+		c = httpClient[0]
+	}
+
+	return &Client{rootUri: rootUri, httpClient: c}
+}
+
+func (c *Client) UseCache(store CacheStore) *Client {
+	c.cache = store
+	return c
+}
+
+func (c *Client) Root() (resource.Resource, error) {
+	return c.RootWithContext(context.Background())
+}
+
+func (c *Client) RootWithContext(ctx context.Context) (resource.Resource, error) {
+	c.rootMutex.Lock()
+	defer c.rootMutex.Unlock()
+
+	if c.root != nil {
+		return copyResource(*c.root), nil
+	}
+
+	root, err := c.GetWithContext(ctx, c.rootUri)
+	if err != nil {
+		return root, err
+	}
+
+	c.root = &root
+	return copyResource(root), nil
+}
+
+func (c *Client) Get(uri string) (resource.Resource, error) {
+	return c.GetWithContext(context.Background(), uri)
+}
+
+func (c *Client) GetWithContext(ctx context.Context, uri string) (resource.Resource, error) {
+	r := resource.NewResource()
+	r.Link("self", uri)
+
+	return c.send(ctx, uri, *r.Links["self"], uri, nil)
+}
+
+func (c *Client) Follow(linkName string) *Request {
+	return newRequest(c, linkName, func(ctx context.Context) (resource.Resource, error) {
+		return c.RootWithContext(ctx)
+	})
+}
+
+func (c *Client) FollowFrom(r resource.Resource, linkName string) *Request {
+	return newRequest(c, linkName, func(context.Context) (resource.Resource, error) {
+		return r, nil
+	})
+}
+
+func (c *Client) resolve(href string) (string, error) {
+	base, err := url.Parse(c.rootUri)
+	if err != nil {
+		return "", err
+	}
+
+	reference, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(reference).String(), nil
+}
+
+func (c *Client) send(ctx context.Context, linkName string, link resource.Link, uri string, body io.Reader) (resource.Resource, error) {
+	uri, err := c.resolve(uri)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, link.Verb, uri, body)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.cache == nil {
+		return c.do(req, linkName, link)
+	}
+
+	if link.Verb != "GET" {
+		r, err := c.do(req, linkName, link)
+		if err == nil {
+			c.cache.Delete(cacheKey(req))
+		}
+		return r, err
+	}
+
+	return c.doCached(req, linkName, link)
+}
+
+func (c *Client) do(req *http.Request, linkName string, link resource.Link) (resource.Resource, error) {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(linkName, link, res)
+}
+
+func decodeResponse(linkName string, link resource.Link, res *http.Response) (resource.Resource, error) {
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+
+	if err := checkStatus(linkName, link, res.StatusCode, content); err != nil {
+		return resource.NewResource(), err
+	}
+
+	return decodeContent(res.Header.Get("Content-Type"), content)
+}
+
+func decodeContent(contentType string, content []byte) (resource.Resource, error) {
+	if len(content) == 0 || !strings.Contains(contentType, "json") {
+		return resource.NewResource(), nil
+	}
+
+	return encoding.UnmarshalJson(content)
+}
+
+type StatusError struct {
+	LinkName   string
+	StatusCode int
+	Expected   bool
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	if !e.Expected {
+		return fmt.Sprintf("unexpected status %d %s following link '%s'", e.StatusCode, http.StatusText(e.StatusCode), e.LinkName)
+	}
+	return fmt.Sprintf("link '%s' returned status %d %s", e.LinkName, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func checkStatus(linkName string, link resource.Link, statusCode int, body []byte) error {
+	expected := false
+	for _, responseCode := range link.ResponseCodes {
+		if responseCode == statusCode {
+			expected = true
+			break
+		}
+	}
+
+	if expected && statusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	return &StatusError{linkName, statusCode, expected, body}
+}
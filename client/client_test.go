@@ -0,0 +1,280 @@
+package client
+
+import (
+	"encoding/json"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testUser struct {
+	Id       int
+	Username string
+	Email    string
+}
+
+var testUsers = []testUser{{1, "ajones", "ajones@aol.com"}, {2, "sanderson", "sanderson@gmail.com"}}
+
+func newTestUserResource(u testUser) resource.Resource {
+	url := resource.ConstructUriFromTemplate("/user/{Id}", u.Id)
+	r := resource.NewResource("User")
+	r.Uri(url)
+	r.MapAllDataFrom(u)
+	r.Link("updateUser", url, option.Verb("PUT")).
+		Parameter("username", option.Default(u.Username)).
+		Parameter("email", option.Default(u.Email))
+	r.Link("deleteUser", url, option.Verb("DELETE"))
+	return r
+}
+
+func newTestServer() (*httptest.Server, *[]*http.Request) {
+	requests := make([]*http.Request, 0)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req)
+
+		body := make(map[string]interface{})
+		_ = json.NewDecoder(req.Body).Decode(&body)
+
+		switch {
+		case req.URL.Path == "/application":
+			r := resource.NewResource("Application")
+			r.Uri("/application")
+			r.Link("searchUsers", "/user").
+				Parameter("username")
+			r.Link("getUser", "/user/{id}", option.Templated())
+			r.Link("createUser", "/user", option.Verb("POST")).
+				Parameter("username").
+				Parameter("email")
+			r.Link("broken", "/broken")
+			_ = encoding.Write(w, req, http.StatusOK, r)
+		case req.URL.Path == "/user" && req.Method == http.MethodGet:
+			r := resource.NewResource("UserList")
+			r.Uri("/user?" + req.URL.RawQuery)
+			users := make([]resource.Resource, 0)
+			for _, u := range testUsers {
+				if strings.Contains(u.Username, req.URL.Query().Get("username")) {
+					users = append(users, newTestUserResource(u))
+				}
+			}
+			r.EmbedResources("users", users)
+			r.Data("count", len(users))
+			_ = encoding.Write(w, req, http.StatusOK, r)
+		case req.URL.Path == "/user" && req.Method == http.MethodPost:
+			u := testUser{3, body["username"].(string), body["email"].(string)}
+			_ = encoding.Write(w, req, http.StatusCreated, newTestUserResource(u))
+		case req.URL.Path == "/user/1" && req.Method == http.MethodGet:
+			_ = encoding.Write(w, req, http.StatusOK, newTestUserResource(testUsers[0]))
+		case req.URL.Path == "/user/1" && req.Method == http.MethodPut:
+			u := testUsers[0]
+			u.Username = body["username"].(string)
+			u.Email = body["email"].(string)
+			_ = encoding.Write(w, req, http.StatusOK, newTestUserResource(u))
+		case req.URL.Path == "/user/1" && req.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("User deleted."))
+		case req.URL.Path == "/broken":
+			w.WriteHeader(http.StatusTeapot)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return httptest.NewServer(handler), &requests
+}
+
+func Test_RootMustFetchRootResource(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Root()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/application", r.Links["self"].Href)
+}
+
+func Test_RootMustOnlyFetchOnce(t *testing.T) {
+	//arrange
+	server, requests := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, _ = c.Root()
+	_, err := c.Root()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Len(*requests, 1)
+}
+
+func Test_RootMustBeSafeForConcurrentUse(t *testing.T) {
+	//arrange
+	server, requests := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			root, _ := c.Root()
+			root.Links["self"].Href = "/changed"
+		}()
+	}
+	wg.Wait()
+	root, err := c.Root()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/application", root.Links["self"].Href)
+	a.Len(*requests, 1)
+}
+
+func Test_FollowMustAddParametersToQueryForGet(t *testing.T) {
+	//arrange
+	server, requests := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Follow("searchUsers").With("username", "aj").Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("username=aj", (*requests)[1].URL.RawQuery)
+	a.Equal(float64(1), r.Values["count"])
+}
+
+func Test_FollowMustExpandTemplatedLinks(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Follow("getUser").With("id", 1).Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Values["Username"])
+}
+
+func Test_FollowMustSendParametersAsBodyUsingVerb(t *testing.T) {
+	//arrange
+	server, requests := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Follow("createUser").
+		With("username", "mwilliams").
+		With("email", "mwilliams@gmail.com").
+		Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.MethodPost, (*requests)[1].Method)
+	a.Equal("mwilliams", r.Values["Username"])
+	a.Equal("/user/3", r.Links["self"].Href)
+}
+
+func Test_FollowFromMustUseLinkParameterDefaults(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+	u, _ := c.Follow("getUser").With("id", 1).Do()
+
+	//act
+	r, err := c.FollowFrom(u, "updateUser").With("email", "aj@gmail.com").Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Values["Username"])
+	a.Equal("aj@gmail.com", r.Values["Email"])
+}
+
+func Test_FollowMustReturnEmptyResourceForNonJsonResponse(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+	u, _ := c.Follow("getUser").With("id", 1).Do()
+
+	//act
+	r, err := c.FollowFrom(u, "deleteUser").Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Empty(r.Values)
+}
+
+func Test_FollowMustReturnErrorForMissingLink(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Follow("missing").Do()
+
+	//assert
+	a := assert.New(t)
+	a.ErrorIs(err, ErrLinkNotFound)
+}
+
+func Test_FollowMustReturnStatusErrorForUnexpectedStatus(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Follow("broken").Do()
+
+	//assert
+	a := assert.New(t)
+	var statusError *StatusError
+	a.ErrorAs(err, &statusError)
+	a.Equal(http.StatusTeapot, statusError.StatusCode)
+	a.False(statusError.Expected)
+	a.Equal("unexpected status 418 I'm a teapot following link 'broken'", err.Error())
+}
+
+func Test_FollowMustReturnStatusErrorForExpectedErrorStatus(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Follow("getUser").With("id", 9).Do()
+
+	//assert
+	a := assert.New(t)
+	var statusError *StatusError
+	a.ErrorAs(err, &statusError)
+	a.Equal(http.StatusNotFound, statusError.StatusCode)
+	a.True(statusError.Expected)
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Request struct {
+	client   *Client
+	linkName string
+	source   func(ctx context.Context) (resource.Resource, error)
+	names    []string
+	values   map[string]interface{}
+}
+
+func newRequest(c *Client, linkName string, source func(ctx context.Context) (resource.Resource, error)) *Request {
+	return &Request{c, linkName, source, make([]string, 0), make(map[string]interface{})}
+}
+
+func (rq *Request) With(name string, value interface{}) *Request {
+	if _, ok := rq.values[name]; !ok {
+		rq.names = append(rq.names, name)
+	}
+	rq.values[name] = value
+
+	return rq
+}
+
+func (rq *Request) Do() (resource.Resource, error) {
+	return rq.DoWithContext(context.Background())
+}
+
+func (rq *Request) DoWithContext(ctx context.Context) (resource.Resource, error) {
+	source, err := rq.source(ctx)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+
+	link, ok := source.Links[rq.linkName]
+	if !ok {
+		return resource.NewResource(), fmt.Errorf("%w: '%s'", ErrLinkNotFound, rq.linkName)
+	}
+
+	uri, remaining := expandTemplate(link.Href, rq.names, rq.values)
+
+	if link.Verb == "GET" {
+		return rq.client.send(ctx, rq.linkName, *link, addQuery(uri, remaining, rq.values), nil)
+	}
+
+	body, err := newBody(*link, remaining, rq.values)
+	if err != nil {
+		return resource.NewResource(), err
+	}
+
+	return rq.client.send(ctx, rq.linkName, *link, uri, body)
+}
+
+func expandTemplate(href string, names []string, values map[string]interface{}) (string, []string) {
+	remaining := make([]string, 0)
+	for _, name := range names {
+		placeholder := "{" + name + "}"
+		if !strings.Contains(href, placeholder) {
+			remaining = append(remaining, name)
+			continue
+		}
+		href = strings.ReplaceAll(href, placeholder, url.PathEscape(fmt.Sprint(values[name])))
+	}
+
+	re := regexp.MustCompile("{[a-zA-Z0-9]*}")
+	return re.ReplaceAllString(href, ""), remaining
+}
+
+func addQuery(uri string, names []string, values map[string]interface{}) string {
+	if len(names) == 0 {
+		return uri
+	}
+
+	query := url.Values{}
+	for _, name := range names {
+		query.Add(name, fmt.Sprint(values[name]))
+	}
+
+	separator := "?"
+	if strings.Contains(uri, "?") {
+		separator = "&"
+	}
+
+	return uri + separator + query.Encode()
+}
+
+func newBody(link resource.Link, names []string, values map[string]interface{}) (io.Reader, error) {
+	body := make(map[string]interface{})
+
+	for _, parameter := range link.Parameters {
+		if parameter.DefaultValue != "" {
+			body[parameter.Name] = convertValue(parameter.DefaultValue, parameter.DataType)
+		}
+	}
+
+	for _, name := range names {
+		body[name] = values[name]
+	}
+
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(content), nil
+}
+
+func convertValue(value string, dataType string) interface{} {
+	switch strings.ToLower(dataType) {
+	case "int", "int32", "int64", "number":
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+	case "float", "float32", "float64":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case "bool", "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+)
+
+var ErrEmbeddedNotFound = errors.New("embedded resource not found")
+var ErrParameterWithoutLink = errors.New("parameter can only be set on a followed link")
+
+type Traversal struct {
+	client *Client
+	start  func(ctx context.Context) (resource.Resource, error)
+	hops   []hop
+	err    error
+}
+
+type hop struct {
+	description string
+	linkName    string
+	names       []string
+	values      map[string]interface{}
+	embedded    string
+	index       int
+	predicate   func(resource.Resource) bool
+	err         error
+}
+
+func (c *Client) Traverse() *Traversal {
+	return &Traversal{c, c.RootWithContext, make([]hop, 0), nil}
+}
+
+func (c *Client) TraverseFrom(r resource.Resource) *Traversal {
+	return &Traversal{c, func(context.Context) (resource.Resource, error) { return r, nil }, make([]hop, 0), nil}
+}
+
+func (t *Traversal) Follow(linkName string) *Traversal {
+	t.hops = append(t.hops, hop{description: linkName, linkName: linkName, values: make(map[string]interface{})})
+	return t
+}
+
+func (t *Traversal) With(name string, value interface{}) *Traversal {
+	if len(t.hops) == 0 {
+		if t.err == nil {
+			t.err = fmt.Errorf("%w: '%s'", ErrParameterWithoutLink, name)
+		}
+		return t
+	}
+
+	h := &t.hops[len(t.hops)-1]
+	if h.linkName == "" {
+		if h.err == nil {
+			h.err = fmt.Errorf("%w: '%s'", ErrParameterWithoutLink, name)
+		}
+		return t
+	}
+
+	if _, ok := h.values[name]; !ok {
+		h.names = append(h.names, name)
+	}
+	h.values[name] = value
+
+	return t
+}
+
+func (t *Traversal) Embedded(name string) *Traversal {
+	return t.EmbeddedAt(name, 0)
+}
+
+func (t *Traversal) EmbeddedAt(name string, index int) *Traversal {
+	description := fmt.Sprintf("_embedded.%s[%d]", name, index)
+	t.hops = append(t.hops, hop{description: description, embedded: name, index: index})
+	return t
+}
+
+func (t *Traversal) EmbeddedWhere(name string, predicate func(resource.Resource) bool) *Traversal {
+	description := fmt.Sprintf("_embedded.%s[?]", name)
+	t.hops = append(t.hops, hop{description: description, embedded: name, predicate: predicate})
+	return t
+}
+
+func (t *Traversal) Do() (resource.Resource, error) {
+	return t.DoWithContext(context.Background())
+}
+
+func (t *Traversal) DoWithContext(ctx context.Context) (resource.Resource, error) {
+	if t.err != nil {
+		return resource.NewResource(), &TraversalError{0, "start", t.err}
+	}
+
+	current, err := t.start(ctx)
+	if err != nil {
+		return current, &TraversalError{0, "start", err}
+	}
+
+	for i, h := range t.hops {
+		if h.err != nil {
+			return current, &TraversalError{i + 1, h.description, h.err}
+		}
+
+		if h.linkName != "" {
+			current, err = t.follow(ctx, current, h)
+		} else {
+			current, err = selectEmbedded(current, h)
+		}
+
+		if err != nil {
+			return current, &TraversalError{i + 1, h.description, err}
+		}
+	}
+
+	return current, nil
+}
+
+func (t *Traversal) follow(ctx context.Context, current resource.Resource, h hop) (resource.Resource, error) {
+	if len(h.names) == 0 {
+		if embeddedResource, ok := current.Embedded[h.linkName].(resource.Resource); ok {
+			return embeddedResource, nil
+		}
+	}
+
+	request := t.client.FollowFrom(current, h.linkName)
+	for _, name := range h.names {
+		request.With(name, h.values[name])
+	}
+
+	return request.DoWithContext(ctx)
+}
+
+func selectEmbedded(current resource.Resource, h hop) (resource.Resource, error) {
+	embedded, ok := current.Embedded[h.embedded]
+	if !ok {
+		return resource.NewResource(), fmt.Errorf("%w: '%s'", ErrEmbeddedNotFound, h.embedded)
+	}
+
+	resources, ok := embedded.([]resource.Resource)
+	if !ok {
+		embeddedResource, ok := embedded.(resource.Resource)
+		if !ok {
+			return resource.NewResource(), fmt.Errorf("%w: '%s'", ErrEmbeddedNotFound, h.embedded)
+		}
+		resources = []resource.Resource{embeddedResource}
+	}
+
+	if h.predicate != nil {
+		for _, r := range resources {
+			if h.predicate(r) {
+				return r, nil
+			}
+		}
+		return resource.NewResource(), fmt.Errorf("%w: no resource in '%s' matches", ErrEmbeddedNotFound, h.embedded)
+	}
+
+	if h.index < 0 || h.index >= len(resources) {
+		return resource.NewResource(), fmt.Errorf("%w: index %d out of range, '%s' has %d resources", ErrEmbeddedNotFound, h.index, h.embedded, len(resources))
+	}
+
+	return resources[h.index], nil
+}
+
+type TraversalError struct {
+	Hop         int
+	Description string
+	Err         error
+}
+
+func (e *TraversalError) Error() string {
+	return fmt.Sprintf("traversal failed at hop %d (%s): %v", e.Hop, e.Description, e.Err)
+}
+
+func (e *TraversalError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func Test_TraverseMustFollowLinksAndEmbeddedResources(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Traverse().
+		Follow("searchUsers").With("username", "aj").
+		EmbeddedAt("users", 0).
+		Follow("updateUser").With("email", "aj@gmail.com").
+		Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Values["Username"])
+	a.Equal("aj@gmail.com", r.Values["Email"])
+}
+
+func Test_TraverseMustSelectEmbeddedResourceByPredicate(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	r, err := c.Traverse().
+		Follow("searchUsers").
+		EmbeddedWhere("users", func(r resource.Resource) bool {
+			return r.Values["Username"] == "sanderson"
+		}).
+		Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/user/2", r.Links["self"].Href)
+}
+
+func Test_TraverseMustUseEmbeddedResourceInsteadOfFetching(t *testing.T) {
+	//arrange
+	server, requests := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	owner := resource.NewResource("User")
+	owner.Data("Username", "ajones")
+	start := resource.NewResource("Account")
+	start.Link("owner", "/user/1")
+	start.EmbedResource("owner", owner)
+
+	//act
+	r, err := c.TraverseFrom(start).Follow("owner").Do()
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Values["Username"])
+	a.Empty(*requests)
+}
+
+func Test_TraverseMustReportHopThatFailed(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Traverse().
+		Follow("searchUsers").With("username", "nobody").
+		EmbeddedAt("users", 0).
+		Follow("updateUser").
+		Do()
+
+	//assert
+	a := assert.New(t)
+	var traversalError *TraversalError
+	a.ErrorAs(err, &traversalError)
+	a.Equal(2, traversalError.Hop)
+	a.Equal("_embedded.users[0]", traversalError.Description)
+	a.ErrorIs(err, ErrEmbeddedNotFound)
+	a.Equal("traversal failed at hop 2 (_embedded.users[0]): embedded resource not found: index 0 out of range, 'users' has 0 resources", err.Error())
+}
+
+func Test_TraverseMustReportParameterSetOnEmbeddedHop(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Traverse().
+		Follow("searchUsers").With("username", "ajones").
+		Embedded("users").With("page", 2).
+		Do()
+
+	//assert
+	a := assert.New(t)
+	var traversalError *TraversalError
+	a.ErrorAs(err, &traversalError)
+	a.Equal(2, traversalError.Hop)
+	a.Equal("_embedded.users[0]", traversalError.Description)
+	a.ErrorIs(err, ErrParameterWithoutLink)
+}
+
+func Test_TraverseMustReportMissingLink(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Traverse().Follow("searchUsers").Follow("missing").Do()
+
+	//assert
+	a := assert.New(t)
+	var traversalError *TraversalError
+	a.ErrorAs(err, &traversalError)
+	a.Equal(2, traversalError.Hop)
+	a.ErrorIs(err, ErrLinkNotFound)
+}
+
+func Test_TraverseMustReportStatusErrors(t *testing.T) {
+	//arrange
+	server, _ := newTestServer()
+	defer server.Close()
+	c := New(server.URL + "/application")
+
+	//act
+	_, err := c.Traverse().Follow("broken").Do()
+
+	//assert
+	a := assert.New(t)
+	var statusError *StatusError
+	a.ErrorAs(err, &statusError)
+	a.Equal(http.StatusTeapot, statusError.StatusCode)
+}
@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedPage(relation string, items []Resource, pageNumber, pageSize, totalItems int, uriTemplate string) *Resource {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	totalPages := (totalItems + pageSize - 1) / pageSize
+	lastPage := max(totalPages, 1)
+
+	r.EmbedResources(relation, items)
+
+	r.Data("page", pageNumber)
+	r.Data("size", pageSize)
+	r.Data("totalItems", totalItems)
+	r.Data("totalPages", totalPages)
+
+	r.Uri(pageUri(uriTemplate, pageNumber, pageSize))
+	r.Link("first", pageUri(uriTemplate, 1, pageSize))
+	r.Link("last", pageUri(uriTemplate, lastPage, pageSize))
+
+	if pageNumber > 1 {
+		r.Link("prev", pageUri(uriTemplate, min(pageNumber-1, lastPage), pageSize))
+	}
+
+	if pageNumber < lastPage {
+		r.Link("next", pageUri(uriTemplate, pageNumber+1, pageSize))
+	}
+
+	r.addPageParametersLink(uriTemplate, map[string]LinkParameter{
+		"{page}": {Name: "page", DefaultValue: strconv.Itoa(pageNumber), DataType: "int"},
+		"{size}": {Name: "size", DefaultValue: strconv.Itoa(pageSize), DataType: "int"},
+	})
+
+	return r
+}
+
+func pageUri(uriTemplate string, pageNumber, pageSize int) string {
+	uri := strings.ReplaceAll(uriTemplate, "{page}", strconv.Itoa(pageNumber))
+	return strings.ReplaceAll(uri, "{size}", strconv.Itoa(pageSize))
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) addPageParametersLink(uriTemplate string, pagingParameters map[string]LinkParameter) {
+	path, query, _ := strings.Cut(uriTemplate, "?")
+	configureLink := r.Link("page", path)
+
+	for _, parameter := range strings.Split(query, "&") {
+		name, value, _ := strings.Cut(parameter, "=")
+		if name == "" {
+			continue
+		}
+
+		if pagingParameter, ok := pagingParameters[value]; ok {
+			pagingParameter.Name = name
+			configureLink.link.Parameters = append(configureLink.link.Parameters, pagingParameter)
+			continue
+		}
+
+		name, _ = url.QueryUnescape(name)
+		value, _ = url.QueryUnescape(value)
+		configureLink.Parameter(name, option.Default(value))
+	}
+}
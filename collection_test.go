@@ -0,0 +1,122 @@
+package resource
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newPageItems(count int) []Resource {
+	items := make([]Resource, count)
+	for i := range items {
+		items[i] = NewResource("User")
+	}
+	return items
+}
+
+func Test_EmbedPageMustEmbedItems(t *testing.T) {
+	//arrange
+	items := newPageItems(10)
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", items, 2, 10, 35, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	a.Equal(items, r.Embedded["users"])
+}
+
+func Test_EmbedPageMustRecordPagingValues(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(10), 2, 10, 35, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	a.Equal(2, r.Values["page"])
+	a.Equal(10, r.Values["size"])
+	a.Equal(35, r.Values["totalItems"])
+	a.Equal(4, r.Values["totalPages"])
+}
+
+func Test_EmbedPageMustAddPagingLinks(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(10), 2, 10, 35, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("/user?page=2&size=10", r.Links["self"].Href)
+	a.Equal("UserList", r.Links["self"].Schema)
+	a.Equal("/user?page=1&size=10", r.Links["first"].Href)
+	a.Equal("/user?page=1&size=10", r.Links["prev"].Href)
+	a.Equal("/user?page=3&size=10", r.Links["next"].Href)
+	a.Equal("/user?page=4&size=10", r.Links["last"].Href)
+}
+
+func Test_EmbedPageMustNotAddPrevLinkOnFirstPage(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(10), 1, 10, 35, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	_, ok := r.Links["prev"]
+	a.False(ok)
+	_, ok = r.Links["next"]
+	a.True(ok)
+}
+
+func Test_EmbedPageMustNotAddNextLinkOnLastPage(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(5), 4, 10, 35, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	_, ok := r.Links["next"]
+	a.False(ok)
+	_, ok = r.Links["prev"]
+	a.True(ok)
+}
+
+func Test_EmbedPageMustHandleEmptyCollection(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(0), 1, 10, 0, "/user?page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	a.Equal(0, r.Values["totalPages"])
+	a.Equal("/user?page=1&size=10", r.Links["last"].Href)
+	_, ok := r.Links["next"]
+	a.False(ok)
+}
+
+func Test_EmbedPageMustExposePagingParameters(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedPage("users", newPageItems(10), 2, 10, 35, "/user?username=aj&page={page}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	link := r.Links["page"]
+	a.Equal("/user", link.Href)
+	a.Equal("GET", link.Verb)
+	a.Len(link.Parameters, 3)
+	a.Equal(LinkParameter{Name: "username", DefaultValue: "aj"}, link.Parameters[0])
+	a.Equal(LinkParameter{Name: "page", DefaultValue: "2", DataType: "int"}, link.Parameters[1])
+	a.Equal(LinkParameter{Name: "size", DefaultValue: "10", DataType: "int"}, link.Parameters[2])
+}
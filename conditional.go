@@ -0,0 +1,66 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+)
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) LinkIf(condition bool, name string, href string, linkOptions ...option.Option) ConfigureLink {
+	if !condition {
+		discarded := NewResource()
+		return discarded.Link(name, href, linkOptions...)
+	}
+
+	return r.Link(name, href, linkOptions...)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) DataIf(condition bool, name string, value interface{}, mapOptions ...option.Option) *Resource {
+	if !condition {
+		return r
+	}
+
+	return r.Data(name, value, mapOptions...)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedIf(condition bool, name string, resource Resource) *Resource {
+	if !condition {
+		return r
+	}
+
+	return r.EmbedResource(name, resource)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedResourcesIf(condition bool, name string, resources []Resource) *Resource {
+	if !condition {
+		return r
+	}
+
+	return r.EmbedResources(name, resources)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) When(condition bool, configure func(r *Resource)) *Resource {
+	if condition {
+		configure(r)
+	}
+
+	return r
+}
+
+func (cm *ConfigureMap) MapIf(fieldName string, predicate func(source interface{}) bool, mapOptions ...option.Option) *ConfigureMap {
+	cm.excludedFields = append(cm.excludedFields, fieldName)
+
+	// remove the field wherever the predicate fails, in case MapAll or Map already copied it
+	for _, copyPair := range cm.copyPairs {
+		for i, v := range copyPair.sourceItems {
+			if !predicate(v) {
+				delete(*copyPair.destinationItems[i], fieldName)
+			}
+		}
+	}
+
+	return cm.mapWhere(fieldName, predicate, mapOptions)
+}
@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_LinkIfMustOnlyAddLinkWhenConditionIsTrue(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+
+	//act
+	r.LinkIf(true, "updateUser", "/user/1", option.Verb("PUT")).
+		Parameter("username")
+	r.LinkIf(false, "deleteUser", "/user/1", option.Verb("DELETE")).
+		Parameter("reason")
+
+	//assert
+	a := assert.New(t)
+	a.Contains(r.Links, "updateUser")
+	a.Equal([]LinkParameter{{Name: "username"}}, r.Links["updateUser"].Parameters)
+	a.NotContains(r.Links, "deleteUser")
+}
+
+func Test_DataIfMustOnlyAddDataWhenConditionIsTrue(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+
+	//act
+	r.DataIf(true, "username", "ajones").
+		DataIf(false, "password", "secret")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("ajones", r.Values["username"])
+	a.NotContains(r.Values, "password")
+}
+
+func Test_EmbedIfMustOnlyEmbedWhenConditionIsTrue(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+
+	//act
+	r.EmbedIf(true, "manager", NewResource("User")).
+		EmbedIf(false, "assistant", NewResource("User")).
+		EmbedResourcesIf(true, "reports", []Resource{NewResource("User")}).
+		EmbedResourcesIf(false, "peers", []Resource{NewResource("User")})
+
+	//assert
+	a := assert.New(t)
+	a.Contains(r.Embedded, "manager")
+	a.NotContains(r.Embedded, "assistant")
+	a.Contains(r.Embedded, "reports")
+	a.NotContains(r.Embedded, "peers")
+}
+
+func Test_WhenMustOnlyConfigureResourceWhenConditionIsTrue(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+	isAdmin := true
+
+	//act
+	r.Data("username", "ajones").
+		When(isAdmin, func(r *Resource) {
+			r.Data("role", "admin")
+			r.Link("deleteUser", "/user/1", option.Verb("DELETE"))
+		}).
+		When(!isAdmin, func(r *Resource) {
+			r.Data("role", "user")
+		})
+
+	//assert
+	a := assert.New(t)
+	a.Equal("admin", r.Values["role"])
+	a.Contains(r.Links, "deleteUser")
+}
+
+func Test_MapIfMustOnlyMapFieldWhenPredicateIsTrue(t *testing.T) {
+	//arrange
+	type testUser struct {
+		Username string
+		Email    string
+		IsPublic bool
+	}
+	isPublic := func(source interface{}) bool { return source.(testUser).IsPublic }
+	users := []interface{}{
+		testUser{"ajones", "ajones@aol.com", true},
+		testUser{"bsmith", "bsmith@aol.com", false},
+	}
+
+	var r Resource
+
+	//act
+	r.MapChild("users", users).
+		MapIf("Email", isPublic, option.Rename("email")).
+		MapAll()
+
+	//assert
+	a := assert.New(t)
+	mappedUsers := r.Values["users"].([]MappedData)
+	a.Equal("ajones@aol.com", mappedUsers[0]["email"])
+	a.NotContains(mappedUsers[1], "email")
+	a.NotContains(mappedUsers[1], "Email")
+	a.Equal("bsmith", mappedUsers[1]["Username"])
+}
+
+func Test_MapIfMustRemoveFieldWhenCalledAfterMapAll(t *testing.T) {
+	//arrange
+	type testUser struct {
+		Username string
+		Email    string
+		IsPublic bool
+	}
+	isPublic := func(source interface{}) bool { return source.(testUser).IsPublic }
+	users := []interface{}{
+		testUser{"ajones", "ajones@aol.com", true},
+		testUser{"bsmith", "bsmith@aol.com", false},
+	}
+
+	var r Resource
+
+	//act
+	r.MapChild("users", users).
+		MapAll().
+		MapIf("Email", isPublic)
+
+	//assert
+	a := assert.New(t)
+	mappedUsers := r.Values["users"].([]MappedData)
+	a.Equal("ajones@aol.com", mappedUsers[0]["Email"])
+	a.NotContains(mappedUsers[1], "Email")
+	a.Equal("bsmith", mappedUsers[1]["Username"])
+}
@@ -0,0 +1,111 @@
+package resource
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type CursorSigner struct {
+	key []byte
+}
+
+func NewCursorSigner(key []byte) CursorSigner {
+	return CursorSigner{key}
+}
+
+func (s CursorSigner) Encode(keySet interface{}) (string, error) {
+	payload, err := json.Marshal(keySet)
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(s.sign(payload)), nil
+}
+
+func (s CursorSigner) Decode(cursor string, keySet interface{}) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+
+	encoding := base64.RawURLEncoding
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(payload)) {
+		return ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(payload, keySet); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+func (s CursorSigner) DecodeFromRequest(req *http.Request, parameterName string, keySet interface{}) (bool, error) {
+	cursor := req.URL.Query().Get(parameterName)
+	if cursor == "" {
+		return false, nil
+	}
+
+	return true, s.Decode(cursor, keySet)
+}
+
+func (s CursorSigner) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+type Cursors struct {
+	Current string
+	Next    string
+	Prev    string
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedCursorPage(relation string, items []Resource, cursors Cursors, pageSize int, uriTemplate string) *Resource {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	r.EmbedResources(relation, items)
+
+	r.Data("size", pageSize)
+
+	r.Uri(cursorUri(uriTemplate, cursors.Current, pageSize))
+	r.Link("first", cursorUri(uriTemplate, "", pageSize))
+
+	if cursors.Prev != "" {
+		r.Link("prev", cursorUri(uriTemplate, cursors.Prev, pageSize))
+	}
+
+	if cursors.Next != "" {
+		r.Link("next", cursorUri(uriTemplate, cursors.Next, pageSize))
+	}
+
+	r.addPageParametersLink(uriTemplate, map[string]LinkParameter{
+		"{cursor}": {Name: "cursor", DefaultValue: cursors.Current},
+		"{size}":   {Name: "size", DefaultValue: strconv.Itoa(pageSize), DataType: "int"},
+	})
+
+	return r
+}
+
+func cursorUri(uriTemplate string, cursor string, pageSize int) string {
+	uri := strings.ReplaceAll(uriTemplate, "{cursor}", cursor)
+	return strings.ReplaceAll(uri, "{size}", strconv.Itoa(pageSize))
+}
@@ -0,0 +1,139 @@
+package resource
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testKeySet struct {
+	Id       int
+	Username string
+}
+
+func Test_CursorSignerMustDecodeEncodedCursor(t *testing.T) {
+	//arrange
+	signer := NewCursorSigner([]byte("secret"))
+	cursor, _ := signer.Encode(testKeySet{42, "ajones"})
+
+	//act
+	var keySet testKeySet
+	err := signer.Decode(cursor, &keySet)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(testKeySet{42, "ajones"}, keySet)
+}
+
+func Test_CursorSignerMustRejectTamperedCursor(t *testing.T) {
+	//arrange
+	signer := NewCursorSigner([]byte("secret"))
+	cursor, _ := signer.Encode(testKeySet{42, "ajones"})
+	forged, _ := signer.Encode(testKeySet{1, "ajones"})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(cursor, ".")
+	tampered := forgedPayload + "." + signature
+
+	//act
+	var keySet testKeySet
+	err := signer.Decode(tampered, &keySet)
+
+	//assert
+	a := assert.New(t)
+	a.ErrorIs(err, ErrInvalidCursor)
+}
+
+func Test_CursorSignerMustRejectMalformedCursor(t *testing.T) {
+	//arrange
+	signer := NewCursorSigner([]byte("secret"))
+
+	//act
+	var keySet testKeySet
+	err := signer.Decode("not-a-cursor", &keySet)
+
+	//assert
+	a := assert.New(t)
+	a.ErrorIs(err, ErrInvalidCursor)
+}
+
+func Test_CursorSignerMustDecodeCursorFromRequest(t *testing.T) {
+	//arrange
+	signer := NewCursorSigner([]byte("secret"))
+	cursor, _ := signer.Encode(testKeySet{42, "ajones"})
+	req := httptest.NewRequest("GET", "/user?cursor="+cursor, nil)
+
+	//act
+	var keySet testKeySet
+	found, err := signer.DecodeFromRequest(req, "cursor", &keySet)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.True(found)
+	a.Equal(42, keySet.Id)
+}
+
+func Test_CursorSignerMustReportMissingCursorInRequest(t *testing.T) {
+	//arrange
+	signer := NewCursorSigner([]byte("secret"))
+	req := httptest.NewRequest("GET", "/user", nil)
+
+	//act
+	var keySet testKeySet
+	found, err := signer.DecodeFromRequest(req, "cursor", &keySet)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.False(found)
+}
+
+func Test_EmbedCursorPageMustAddCursorLinks(t *testing.T) {
+	//arrange
+	items := newPageItems(10)
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedCursorPage("users", items, Cursors{"c2", "c3", "c1"}, 10, "/user?cursor={cursor}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	a.Equal(items, r.Embedded["users"])
+	a.Equal(10, r.Values["size"])
+	a.Equal("/user?cursor=c2&size=10", r.Links["self"].Href)
+	a.Equal("/user?cursor=&size=10", r.Links["first"].Href)
+	a.Equal("/user?cursor=c1&size=10", r.Links["prev"].Href)
+	a.Equal("/user?cursor=c3&size=10", r.Links["next"].Href)
+}
+
+func Test_EmbedCursorPageMustOmitMissingCursorLinks(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedCursorPage("users", newPageItems(3), Cursors{}, 10, "/user?cursor={cursor}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	_, ok := r.Links["prev"]
+	a.False(ok)
+	_, ok = r.Links["next"]
+	a.False(ok)
+}
+
+func Test_EmbedCursorPageMustExposePagingParameters(t *testing.T) {
+	//arrange
+	r := NewResource("UserList")
+
+	//act
+	r.EmbedCursorPage("users", newPageItems(3), Cursors{Current: "c2"}, 10, "/user?after={cursor}&size={size}")
+
+	//assert
+	a := assert.New(t)
+	link := r.Links["page"]
+	a.Equal("/user", link.Href)
+	a.Equal(LinkParameter{Name: "after", DefaultValue: "c2"}, link.Parameters[0])
+	a.Equal(LinkParameter{Name: "size", DefaultValue: "10", DataType: "int"}, link.Parameters[1])
+}
@@ -0,0 +1,41 @@
+package echo
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"strings"
+)
+
+func AbsoluteUri(c echo.Context, href string) string {
+	if strings.Contains(href, "://") {
+		return href
+	}
+
+	if !strings.HasPrefix(href, "/") {
+		href = "/" + href
+	}
+
+	return c.Scheme() + "://" + c.Request().Host + href
+}
+
+func AbsoluteUriFromTemplate(c echo.Context, template string, parameters ...interface{}) string {
+	return AbsoluteUri(c, resource.ConstructUriFromTemplate(template, parameters...))
+}
+
+func AbsoluteLinks(c echo.Context, r *resource.Resource) *resource.Resource {
+	for _, link := range r.Links {
+		link.Href = AbsoluteUri(c, link.Href)
+	}
+
+	for name, embedded := range r.Embedded {
+		if embeddedResource, ok := embedded.(resource.Resource); ok {
+			r.Embedded[name] = *AbsoluteLinks(c, &embeddedResource)
+		} else if embeddedResourceList, ok := embedded.([]resource.Resource); ok {
+			for i := range embeddedResourceList {
+				AbsoluteLinks(c, &embeddedResourceList[i])
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,69 @@
+package echo
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestContext() echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/user", nil)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func Test_AbsoluteUriMustAddSchemeAndHost(t *testing.T) {
+	//arrange
+	c := newTestContext()
+
+	//act
+	uri := AbsoluteUri(c, "/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("http://example.com/user/1", uri)
+}
+
+func Test_AbsoluteUriMustNotChangeAbsoluteUri(t *testing.T) {
+	//arrange
+	c := newTestContext()
+
+	//act
+	uri := AbsoluteUri(c, "https://other.com/user/1")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("https://other.com/user/1", uri)
+}
+
+func Test_AbsoluteUriFromTemplateMustConstructUri(t *testing.T) {
+	//arrange
+	c := newTestContext()
+
+	//act
+	uri := AbsoluteUriFromTemplate(c, "/user/{Id}", 5)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("http://example.com/user/5", uri)
+}
+
+func Test_AbsoluteLinksMustUpdateLinksAndEmbeddedLinks(t *testing.T) {
+	//arrange
+	c := newTestContext()
+	r := resource.NewResource("UserList")
+	r.Uri("/user")
+	r.EmbedResources("users", []resource.Resource{newTestResource()})
+	r.EmbedResource("owner", newTestResource())
+
+	//act
+	AbsoluteLinks(c, &r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("http://example.com/user", r.Links["self"].Href)
+	a.Equal("http://example.com/user/1", r.Embedded["users"].([]resource.Resource)[0].Links["self"].Href)
+	a.Equal("http://example.com/user/1", r.Embedded["owner"].(resource.Resource).Links["self"].Href)
+}
@@ -0,0 +1,47 @@
+package echo
+
+import (
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"net/http"
+)
+
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	message := http.StatusText(status)
+
+	var httpError *echo.HTTPError
+	var validationErrors encoding.ValidationErrors
+	var statusCoder interface{ StatusCode() int }
+	if errors.As(err, &httpError) {
+		status = httpError.Code
+		message = fmt.Sprint(httpError.Message)
+	} else if errors.As(err, &validationErrors) {
+		problem := encoding.NewValidationProblem(validationErrors).Instance(c.Request().URL.Path).Resource()
+		if err := Respond(c, http.StatusBadRequest, problem); err != nil {
+			c.Logger().Error(err)
+		}
+		return
+	} else if errors.As(err, &statusCoder) {
+		status = statusCoder.StatusCode()
+		message = err.Error()
+	}
+
+	problem := NewErrorResource(status, message)
+	problem.Data("instance", c.Request().URL.Path)
+
+	if err := Respond(c, status, problem); err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+func NewErrorResource(status int, message string) resource.Resource {
+	return encoding.NewProblem(status).Detail(message).Resource()
+}
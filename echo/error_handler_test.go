@@ -0,0 +1,103 @@
+package echo
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource/encoding"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_HTTPErrorHandlerMustRenderHttpErrorAsResource(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.GET("/user/5", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "User not found.")
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/5", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusNotFound, w.Code)
+	a.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	a.Equal(`{"detail":"User not found.","instance":"/user/5","status":404,"title":"Not Found","type":"about:blank"}`, w.Body.String())
+}
+
+func Test_HTTPErrorHandlerMustRenderInNegotiatedFormat(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	req := httptest.NewRequest(http.MethodGet, "/missing", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusNotFound, w.Code)
+	a.Equal("application/problem+xml", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), "<status>404</status>")
+}
+
+func Test_HTTPErrorHandlerMustUseInternalServerErrorForOtherErrors(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.GET("/user", func(c echo.Context) error {
+		return errors.New("database unavailable")
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusInternalServerError, w.Code)
+	a.Equal(`{"detail":"Internal Server Error","instance":"/user","status":500,"title":"Internal Server Error","type":"about:blank"}`, w.Body.String())
+}
+
+func Test_HTTPErrorHandlerMustUseStatusCodeFromError(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.POST("/user", func(c echo.Context) error {
+		var u struct{ Username string }
+		return encoding.Decode(c.Request(), &u)
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader("username"))
+	req.Header.Set("Content-Type", "text/plain")
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func Test_HTTPErrorHandlerMustRenderValidationErrorsAsProblem(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.POST("/user", func(c echo.Context) error {
+		return encoding.ValidationErrors{{Field: "username", Message: "is required"}}
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusBadRequest, w.Code)
+	a.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), `"invalid-params":[{"name":"username","reason":"is required"}]`)
+}
@@ -0,0 +1,57 @@
+package echo
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"github.com/slyjeff/rest-resource/option"
+	"io"
+)
+
+type Renderer struct {
+	MarshalOptions []option.Option
+}
+
+func (rr Renderer) Render(w io.Writer, _ string, data interface{}, c echo.Context) error {
+	r, ok := data.(resource.Resource)
+	if !ok {
+		pr, ok := data.(*resource.Resource)
+		if !ok || pr == nil {
+			return errors.New("data to render must be a resource.Resource")
+		}
+		r = *pr
+	}
+
+	marshalOptions := rr.MarshalOptions
+	if _, ok := option.FindAtomBaseUriOption(marshalOptions); !ok {
+		marshalOptions = append(marshalOptions[:len(marshalOptions):len(marshalOptions)], option.AtomBaseUri(c.Scheme()+"://"+c.Request().Host))
+	}
+
+	if option.FindExpandEmbeddedOption(marshalOptions) {
+		r.SelectEmbedded(resource.EmbedsFromQuery(c.QueryParams()))
+	}
+
+	if err := r.ResolveEmbedded(c.Request().Context(), option.FindEmbedWorkersOption(marshalOptions)); err != nil {
+		return err
+	}
+
+	value, err := encoding.MarshalResponse(c.Response().Header(), c.Request().Header, r, marshalOptions...)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, value)
+	return err
+}
+
+func Respond(c echo.Context, status int, r resource.Resource, writeOptions ...option.Option) error {
+	return encoding.Write(c.Response(), c.Request(), status, r, writeOptions...)
+}
+
+func Configure(e *echo.Echo, marshalOptions ...option.Option) *echo.Echo {
+	e.Renderer = Renderer{marshalOptions}
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.Pre(MethodOverride())
+	return e
+}
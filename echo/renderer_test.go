@@ -0,0 +1,185 @@
+package echo
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestResource() resource.Resource {
+	r := resource.NewResource("User")
+	r.Uri("/user/1")
+	r.Data("Username", "ajones")
+	return r
+}
+
+func Test_RendererMustRenderResourceInNegotiatedFormat(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.GET("/user/1", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "", newTestResource())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	a.Equal("application/xml", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), "<Username>ajones</Username>")
+}
+
+func Test_RendererMustResolveEmbeddedResources(t *testing.T) {
+	//arrange
+	e := Configure(echo.New(), option.ExpandEmbedded())
+	e.GET("/user/1", func(c echo.Context) error {
+		r := newTestResource()
+		r.EmbedFunc("manager", func(context.Context) (resource.Resource, error) {
+			return resource.NewResource("User"), nil
+		})
+		r.EmbedResourceOnRequest("groups", "/user/1/groups", func(context.Context) (resource.Resource, error) {
+			return resource.NewResource("GroupList"), nil
+		})
+		return c.Render(http.StatusOK, "", r)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/1?embed=groups", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	a.Contains(w.Body.String(), `"manager":{}`)
+	a.Contains(w.Body.String(), `"groups":{}`)
+}
+
+func Test_RendererMustAcceptResourcePointer(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.GET("/user/1", func(c echo.Context) error {
+		r := newTestResource()
+		return c.Render(http.StatusOK, "", &r)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	a.Equal("application/json", w.Header().Get("Content-Type"))
+}
+
+func Test_RendererMustReturnErrorIfDataIsNotResource(t *testing.T) {
+	//arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	//act
+	err := Renderer{}.Render(httptest.NewRecorder().Body, "", "not a resource", c)
+
+	//assert
+	a := assert.New(t)
+	a.Error(err)
+}
+
+func Test_RespondMustWriteResource(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.POST("/user", func(c echo.Context) error {
+		return Respond(c, http.StatusCreated, newTestResource())
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusCreated, w.Code)
+	a.Equal("/user/1", w.Header().Get("Location"))
+	a.Contains(w.Body.String(), `"Username":"ajones"`)
+}
+
+func Test_ConfigureMustOverrideMethodFromForm(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.PUT("/user/1", func(c echo.Context) error {
+		return Respond(c, http.StatusOK, newTestResource())
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user/1", nil)
+	req.PostForm = map[string][]string{"_method": {"PUT"}}
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+}
+
+func Test_ConfigureMustOverrideMethodFromHeader(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.DELETE("/user/1", func(c echo.Context) error {
+		return c.String(http.StatusOK, "deleted")
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user/1", nil)
+	req.Header.Set("X-HTTP-Method-Override", "DELETE")
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("deleted", w.Body.String())
+}
+
+func Test_RendererMustSetLinkHeaderIfConfigured(t *testing.T) {
+	//arrange
+	e := Configure(echo.New(), option.LinkHeader())
+	e.GET("/user/1", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "", newTestResource())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	assert.Equal(t, `</user/1>; rel="self"`, w.Header().Get("Link"))
+}
+
+func Test_RendererMustNotSetLinkHeaderByDefault(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	e.GET("/user/1", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "", newTestResource())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	assert.Empty(t, w.Header().Get("Link"))
+}
@@ -0,0 +1,46 @@
+package echo
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+func Route(e *echo.Echo, link resource.Link, handler echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return e.Add(link.Verb, RoutePath(link.Href), handler, m...)
+}
+
+func RouteLinks(e *echo.Echo, r resource.Resource, handlers map[string]echo.HandlerFunc) []*echo.Route {
+	names := make([]string, 0, len(handlers))
+	for name := range handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	routes := make([]*echo.Route, 0, len(names))
+	for _, name := range names {
+		link, ok := r.Links[name]
+		if !ok {
+			continue
+		}
+		routes = append(routes, Route(e, *link, handlers[name]))
+	}
+
+	return routes
+}
+
+func RoutePath(href string) string {
+	if i := strings.IndexAny(href, "?#"); i >= 0 {
+		href = href[:i]
+	}
+
+	re := regexp.MustCompile("{([a-zA-Z0-9]*)}")
+	return re.ReplaceAllString(href, ":$1")
+}
+
+func MethodOverride() echo.MiddlewareFunc {
+	return echo.WrapMiddleware(encoding.MethodOverride)
+}
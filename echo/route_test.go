@@ -0,0 +1,74 @@
+package echo
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_RoutePathMustConvertTemplateParameters(t *testing.T) {
+	//arrange
+	//act
+	path := RoutePath("/user/{Id}/message/{MessageId}?page=1")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("/user/:Id/message/:MessageId", path)
+}
+
+func Test_RouteMustRegisterHandlerForLinkVerbAndPath(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	r := resource.NewResource("User")
+	r.Link("deleteUser", "/user/{Id}", option.Verb("DELETE"))
+
+	Route(e, *r.Links["deleteUser"], func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Param("Id"))
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/user/7", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	a.Equal("7", w.Body.String())
+}
+
+func Test_RouteLinksMustRegisterHandlersByLinkName(t *testing.T) {
+	//arrange
+	e := Configure(echo.New())
+	r := resource.NewResource("User")
+	r.Uri("/user/{Id}")
+	r.Link("updateUser", "/user/{Id}", option.Verb("PUT"))
+
+	routes := RouteLinks(e, r, map[string]echo.HandlerFunc{
+		"self": func(c echo.Context) error {
+			return c.String(http.StatusOK, "get")
+		},
+		"updateUser": func(c echo.Context) error {
+			return c.String(http.StatusOK, "update")
+		},
+		"missing": func(c echo.Context) error {
+			return nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	e.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Len(routes, 2)
+	a.Equal("update", w.Body.String())
+}
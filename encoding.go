@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 )
 
 func (fd FormattedData) MarshalJSON() ([]byte, error) {
@@ -26,6 +28,18 @@ func (l *Link) MarshalJSON() ([]byte, error) {
 		json = addToJson(json, "templated", "true")
 	}
 
+	if l.Title != "" {
+		json = addToJson(json, "title", quoted(l.Title))
+	}
+
+	if l.Type != "" {
+		json = addToJson(json, "type", quoted(l.Type))
+	}
+
+	if l.Disabled {
+		json = addToJson(json, "disabled", "true")
+	}
+
 	if len(l.Parameters) > 0 {
 		parametersJson := "{}"
 		for _, parameter := range l.Parameters {
@@ -43,6 +57,42 @@ func (l *Link) MarshalJSON() ([]byte, error) {
 				parameterValues = addToJson(parameterValues, "dataType", quoted(parameter.DataType))
 			}
 
+			if parameter.Required {
+				parameterValues = addToJson(parameterValues, "required", "true")
+			}
+
+			if parameter.Description != "" {
+				parameterValues = addToJson(parameterValues, "description", quoted(parameter.Description))
+			}
+
+			if parameter.Min != "" {
+				parameterValues = addToJson(parameterValues, "min", quoted(parameter.Min))
+			}
+
+			if parameter.Max != "" {
+				parameterValues = addToJson(parameterValues, "max", quoted(parameter.Max))
+			}
+
+			if parameter.MinLength > 0 {
+				parameterValues = addToJson(parameterValues, "minLength", strconv.Itoa(parameter.MinLength))
+			}
+
+			if parameter.MaxLength > 0 {
+				parameterValues = addToJson(parameterValues, "maxLength", strconv.Itoa(parameter.MaxLength))
+			}
+
+			if parameter.Pattern != "" {
+				parameterValues = addToJson(parameterValues, "pattern", quoted(parameter.Pattern))
+			}
+
+			if parameter.Multiple {
+				parameterValues = addToJson(parameterValues, "multiple", "true")
+			}
+
+			if parameter.Format != "" {
+				parameterValues = addToJson(parameterValues, "format", quoted(parameter.Format))
+			}
+
 			parametersJson = addToJson(parametersJson, parameter.Name, parameterValues)
 		}
 		json = addToJson(json, "parameters", parametersJson)
@@ -52,6 +102,8 @@ func (l *Link) MarshalJSON() ([]byte, error) {
 }
 
 func quoted(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
 	return "\"" + s + "\""
 }
 
@@ -99,6 +151,8 @@ func addXmlTokens(tokens []xml.Token, k string, v interface{}) []xml.Token {
 		tokens = append(tokens, xml.CharData(fmt.Sprint(v)))
 	} else if slice, ok := v.([]MappedData); ok {
 		tokens = addSliceXmlTokens(tokens, slice)
+	} else if items, ok := v.([]interface{}); ok {
+		tokens = addItemsXmlTokens(tokens, items)
 	} else if md, ok := v.(MappedData); ok {
 		tokens = addMapDataXmlTokens(tokens, md)
 	} else {
@@ -117,6 +171,21 @@ func addSliceXmlTokens(tokens []xml.Token, slice []MappedData) []xml.Token {
 	return tokens
 }
 
+func addItemsXmlTokens(tokens []xml.Token, items []interface{}) []xml.Token {
+	for _, item := range items {
+		tokens = append(tokens, xml.StartElement{Name: xml.Name{Local: "Value"}})
+		if md, ok := item.(MappedData); ok {
+			tokens = addMapDataXmlTokens(tokens, md)
+		} else if formattedData, ok := item.(FormattedData); ok {
+			tokens = append(tokens, xml.CharData(formattedData.FormattedString()))
+		} else {
+			tokens = append(tokens, xml.CharData(fmt.Sprint(item)))
+		}
+		tokens = append(tokens, xml.EndElement{Name: xml.Name{Local: "Value"}})
+	}
+	return tokens
+}
+
 func addMapDataXmlTokens(tokens []xml.Token, md MappedData) []xml.Token {
 	keys := make([]string, 0)
 	for k := range md {
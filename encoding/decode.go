@@ -0,0 +1,338 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var SupportedRequestMediaTypes = []string{
+	"application/json",
+	"application/hal+json",
+	"application/xml",
+	"application/x-www-form-urlencoded",
+	"multipart/form-data",
+}
+
+type UnsupportedMediaTypeError struct {
+	ContentType string
+	Supported   []string
+}
+
+func (e *UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("unsupported media type '%s'; supported media types are %s", e.ContentType, strings.Join(e.Supported, ", "))
+}
+
+func (e *UnsupportedMediaTypeError) StatusCode() int {
+	return http.StatusUnsupportedMediaType
+}
+
+func Decode(req *http.Request, target interface{}, link ...resource.Link) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("decode target must be a pointer to a struct")
+	}
+
+	values, err := readBodyValues(req)
+	if err != nil {
+		return err
+	}
+
+	var parameters []resource.LinkParameter
+	if len(link) > 0 {
+		parameters = link[0].Parameters
+	}
+
+	return assignValues(v.Elem(), values, parameters)
+}
+
+func readBodyValues(req *http.Request) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	contentType := req.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/json"
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, &UnsupportedMediaTypeError{contentType, SupportedRequestMediaTypes}
+	}
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data":
+		if err := parseForm(req, mediaType); err != nil {
+			return nil, ValidationErrors{{"body", "must be a valid " + mediaType + " body"}}
+		}
+
+		for name, formValues := range req.PostForm {
+			values[name] = formValues
+		}
+		return values, nil
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		content, err := readBody(req)
+		if err != nil || len(content) == 0 {
+			return values, err
+		}
+
+		if err := json.Unmarshal(content, &values); err != nil {
+			return nil, ValidationErrors{{"body", "must be a valid json object"}}
+		}
+
+		delete(values, "_links")
+		delete(values, "_embedded")
+		return values, nil
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		content, err := readBody(req)
+		if err != nil || len(content) == 0 {
+			return values, err
+		}
+
+		if err := readXmlValues(content, values); err != nil {
+			return nil, ValidationErrors{{"body", "must be a valid xml document"}}
+		}
+		return values, nil
+	}
+
+	return nil, &UnsupportedMediaTypeError{mediaType, SupportedRequestMediaTypes}
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(content))
+
+	return bytes.TrimSpace(content), nil
+}
+
+func readXmlValues(content []byte, values map[string]interface{}) error {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+
+	depth := 0
+	name := ""
+	text := ""
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 {
+				name, text = t.Name.Local, ""
+			}
+		case xml.CharData:
+			if depth == 2 {
+				text += string(t)
+			}
+		case xml.EndElement:
+			if depth == 2 {
+				if existing, ok := values[name].([]string); ok {
+					values[name] = append(existing, text)
+				} else {
+					values[name] = []string{text}
+				}
+			}
+			depth--
+		}
+	}
+}
+
+func assignValues(v reflect.Value, values map[string]interface{}, parameters []resource.LinkParameter) error {
+	validationErrors := make(ValidationErrors, 0)
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, value, ok := findFieldValue(field, values)
+		if !ok {
+			continue
+		}
+
+		dataType := ""
+		if parameters != nil {
+			parameter, ok := findParameter(parameters, name)
+			if !ok {
+				continue
+			}
+			name, dataType = parameter.Name, parameter.DataType
+		}
+
+		if err := assignValue(v.Field(i), value, dataType); err != nil {
+			validationErrors = append(validationErrors, FieldError{name, err.Error()})
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+	return nil
+}
+
+func findFieldValue(field reflect.StructField, values map[string]interface{}) (string, interface{}, bool) {
+	names := make([]string, 0)
+	for _, tag := range []string{"form", "query", "json", "xml"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(tag), ","); name == "-" {
+			return "", nil, false
+		} else if name != "" {
+			names = append(names, name)
+		}
+	}
+	names = append(names, field.Name)
+
+	for _, name := range names {
+		for key, value := range values {
+			if strings.EqualFold(key, name) {
+				return key, value, true
+			}
+		}
+	}
+
+	return "", nil, false
+}
+
+func findParameter(parameters []resource.LinkParameter, name string) (resource.LinkParameter, bool) {
+	for _, parameter := range parameters {
+		if strings.EqualFold(parameter.Name, name) {
+			return parameter, true
+		}
+	}
+	return resource.LinkParameter{}, false
+}
+
+func assignValue(field reflect.Value, value interface{}, dataType string) error {
+	if field.Kind() == reflect.Pointer {
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+
+		element := reflect.New(field.Type().Elem())
+		if err := assignValue(element.Elem(), value, dataType); err != nil {
+			return err
+		}
+		field.Set(element)
+		return nil
+	}
+
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		items := receivedItems(value)
+		slice := reflect.MakeSlice(field.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := assignValue(slice.Index(i), item, dataType); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+
+	if items, ok := value.([]string); ok {
+		if len(items) == 0 {
+			return nil
+		}
+		value = items[0]
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	if dataType != "" {
+		converted, ok := convertParameterValue(value, dataType)
+		if !ok {
+			return errors.New("must be of type " + dataType)
+		}
+		value = converted
+	}
+
+	s, isString := value.(string)
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(fmt.Sprintf("%v", value))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		converted, ok := convertParameterValue(value, "int")
+		if !ok {
+			return errors.New("must be of type int")
+		}
+		if field.OverflowInt(int64(converted.(int))) {
+			return errors.New("is out of range for " + field.Kind().String())
+		}
+		field.SetInt(int64(converted.(int)))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		converted, ok := convertParameterValue(value, "int")
+		if !ok {
+			return errors.New("must be of type int")
+		}
+		if converted.(int) < 0 || field.OverflowUint(uint64(converted.(int))) {
+			return errors.New("is out of range for " + field.Kind().String())
+		}
+		field.SetUint(uint64(converted.(int)))
+	case reflect.Float32, reflect.Float64:
+		converted, ok := convertParameterValue(value, "float")
+		if !ok {
+			return errors.New("must be of type float")
+		}
+		if field.OverflowFloat(converted.(float64)) {
+			return errors.New("is out of range for " + field.Kind().String())
+		}
+		field.SetFloat(converted.(float64))
+	case reflect.Bool:
+		converted, ok := convertParameterValue(value, "bool")
+		if !ok {
+			return errors.New("must be of type bool")
+		}
+		field.SetBool(converted.(bool))
+	default:
+		if _, ok := field.Interface().(time.Time); ok && isString {
+			t, ok := parseDateTime(s)
+			if !ok {
+				return errors.New("is not valid")
+			}
+			field.Set(reflect.ValueOf(t))
+			return nil
+		}
+
+		if unmarshaler, ok := field.Addr().Interface().(interface{ UnmarshalText([]byte) error }); ok && isString {
+			if err := unmarshaler.UnmarshalText([]byte(s)); err != nil {
+				return errors.New("is not valid")
+			}
+			return nil
+		}
+
+		content, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(content, field.Addr().Interface()); err != nil {
+			return errors.New("is not valid")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package encoding
+
+import (
+	"bytes"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type decodeTestUser struct {
+	Id       int
+	Username string   `form:"username" json:"username"`
+	Email    string   `form:"email" json:"email"`
+	IsActive bool     `form:"is_active" json:"isActive"`
+	Roles    []string `form:"roles" json:"roles"`
+}
+
+func newDecodeTestRequest(contentType string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+func Test_DecodeMustDecodeJson(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/json; charset=utf-8", `{"username":"ajones","email":"ajones@aol.com","isActive":true,"roles":["admin"]}`)
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(decodeTestUser{0, "ajones", "ajones@aol.com", true, []string{"admin"}}, u)
+}
+
+func Test_DecodeMustIgnoreHalLinksAndEmbedded(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/hal+json", `{"username":"ajones","_links":{"self":{"href":"/user/1"}},"_embedded":{"manager":{}}}`)
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", u.Username)
+}
+
+func Test_DecodeMustDecodeXml(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/xml", `<resource><username>ajones</username><isActive>true</isActive><roles>admin</roles><roles>user</roles></resource>`)
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(decodeTestUser{0, "ajones", "", true, []string{"admin", "user"}}, u)
+}
+
+func Test_DecodeMustDecodeForm(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/x-www-form-urlencoded", "username=ajones&is_active=true&roles=admin&roles=user")
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(decodeTestUser{0, "ajones", "", true, []string{"admin", "user"}}, u)
+}
+
+func Test_DecodeMustDecodeMultipartForm(t *testing.T) {
+	//arrange
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("username", "ajones")
+	_ = writer.WriteField("email", "ajones@aol.com")
+	_ = writer.Close()
+	req := newDecodeTestRequest(writer.FormDataContentType(), body.String())
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", u.Username)
+	a.Equal("ajones@aol.com", u.Email)
+}
+
+func Test_DecodeMustOnlyAssignLinkParameters(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("updateUser", "/user/1", option.Verb("PUT")).
+		Parameter("username").
+		Parameter("email")
+	req := newDecodeTestRequest("application/json", `{"Id":5,"username":"ajones","email":"ajones@aol.com","isActive":true}`)
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u, *r.Links["updateUser"])
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(decodeTestUser{0, "ajones", "ajones@aol.com", false, nil}, u)
+}
+
+func Test_DecodeMustReturnFieldErrorsForInvalidTypes(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/x-www-form-urlencoded", "Id=abc&is_active=maybe")
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{{"Id", "must be of type int"}, {"is_active", "must be of type bool"}}, validationErrors)
+}
+
+func Test_DecodeMustReturnFieldErrorsForOverflow(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/json", `{"Age":300,"Count":-1,"Ratio":1e40}`)
+	var target struct {
+		Age   int8
+		Count uint16
+		Ratio float32
+	}
+
+	//act
+	err := Decode(req, &target)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{{"Age", "is out of range for int8"}, {"Count", "is out of range for uint16"}, {"Ratio", "is out of range for float32"}}, validationErrors)
+}
+
+func Test_DecodeMustConvertUsingParameterDataType(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("updateUser", "/user/1", option.Verb("PUT")).
+		Parameter("username", option.DataType("int")).
+		Parameter("email")
+	req := newDecodeTestRequest("application/json", `{"username":"ajones","email":"ajones@aol.com"}`)
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u, *r.Links["updateUser"])
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{{"username", "must be of type int"}}, validationErrors)
+}
+
+func Test_DecodeMustReturnFieldErrorForMalformedForm(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/x-www-form-urlencoded", "username=%zz")
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal("body", validationErrors[0].Field)
+}
+
+func Test_DecodeMustReadDateTimeLocalValues(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("application/x-www-form-urlencoded", "startsAt=2024-04-01T10%3A00&endsAt=2024-04-01T11%3A30%3A00Z")
+	var event struct {
+		StartsAt time.Time  `form:"startsAt"`
+		EndsAt   *time.Time `form:"endsAt"`
+	}
+
+	//act
+	err := Decode(req, &event)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC), event.StartsAt)
+	a.Equal(time.Date(2024, 4, 1, 11, 30, 0, 0, time.UTC), *event.EndsAt)
+}
+
+func Test_DecodeMustReturnUnsupportedMediaType(t *testing.T) {
+	//arrange
+	req := newDecodeTestRequest("text/csv", "username\najones")
+	var u decodeTestUser
+
+	//act
+	err := Decode(req, &u)
+
+	//assert
+	a := assert.New(t)
+	var unsupportedMediaTypeError *UnsupportedMediaTypeError
+	a.ErrorAs(err, &unsupportedMediaTypeError)
+	a.Equal("text/csv", unsupportedMediaTypeError.ContentType)
+	a.Equal(http.StatusUnsupportedMediaType, unsupportedMediaTypeError.StatusCode())
+}
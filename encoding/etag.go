@@ -0,0 +1,139 @@
+package encoding
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/slyjeff/rest-resource"
+	"hash"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+func ETag(r resource.Resource, contentType ...string) string {
+	return "\"" + hashResource(r, contentType) + "\""
+}
+
+func WeakETag(r resource.Resource, contentType ...string) string {
+	return "W/" + ETag(r, contentType...)
+}
+
+func hashResource(r resource.Resource, contentType []string) string {
+	h := sha256.New()
+	if len(contentType) > 0 {
+		h.Write([]byte(contentType[0]))
+		h.Write([]byte{0})
+	}
+	writeResourceHash(h, r)
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+func writeResourceHash(h hash.Hash, r resource.Resource) {
+	h.Write([]byte(r.Schema))
+	h.Write([]byte{0})
+
+	if values, err := json.Marshal(r.Values); err == nil {
+		h.Write(values)
+	}
+	h.Write([]byte{0})
+
+	if links, err := json.Marshal(r.Links); err == nil {
+		h.Write(links)
+	}
+	h.Write([]byte{0})
+
+	names := make([]string, 0, len(r.Embedded))
+	for name := range r.Embedded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		if embeddedResource, ok := r.Embedded[name].(resource.Resource); ok {
+			writeResourceHash(h, embeddedResource)
+		} else if embeddedResourceList, ok := r.Embedded[name].([]resource.Resource); ok {
+			for _, embeddedResource := range embeddedResourceList {
+				writeResourceHash(h, embeddedResource)
+			}
+		}
+		h.Write([]byte{0})
+	}
+}
+
+func EvaluatePreconditions(req *http.Request, etag string, lastModified time.Time) (int, bool) {
+	isRead := req.Method == http.MethodGet || req.Method == http.MethodHead
+
+	if ifMatch := req.Header.Get("If-Match"); ifMatch != "" {
+		if !etagMatches(ifMatch, etag, true) {
+			return http.StatusPreconditionFailed, false
+		}
+	} else if ifUnmodifiedSince, ok := parseHttpTime(req.Header.Get("If-Unmodified-Since")); ok && !lastModified.IsZero() {
+		if lastModified.Truncate(time.Second).After(ifUnmodifiedSince) {
+			return http.StatusPreconditionFailed, false
+		}
+	}
+
+	if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		if !etagMatches(ifNoneMatch, etag, false) {
+			return http.StatusOK, true
+		}
+		if isRead {
+			return http.StatusNotModified, false
+		}
+		return http.StatusPreconditionFailed, false
+	}
+
+	if ifModifiedSince, ok := parseHttpTime(req.Header.Get("If-Modified-Since")); ok && isRead && !lastModified.IsZero() {
+		if !lastModified.Truncate(time.Second).After(ifModifiedSince) {
+			return http.StatusNotModified, false
+		}
+	}
+
+	return http.StatusOK, true
+}
+
+func EvaluateResourcePreconditions(req *http.Request, r resource.Resource, lastModified ...time.Time) (int, bool) {
+	modified := time.Time{}
+	if len(lastModified) > 0 {
+		modified = lastModified[0]
+	}
+
+	contentType, _ := negotiateEncoder(req.Header, r)
+	return EvaluatePreconditions(req, ETag(r, contentType), modified)
+}
+
+func etagMatches(header string, etag string, strong bool) bool {
+	if etag == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+
+		if strong && (strings.HasPrefix(candidate, "W/") || strings.HasPrefix(etag, "W/")) {
+			continue
+		}
+
+		if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseHttpTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	t, err := http.ParseTime(value)
+	return t, err == nil
+}
@@ -0,0 +1,288 @@
+package encoding
+
+import (
+	"context"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newETagTestResource() resource.Resource {
+	r := resource.NewResource("User")
+	r.Uri("/user/1")
+	r.Data("Username", "ajones")
+	r.Data("Email", "ajones@aol.com")
+	r.Link("updateUser", "/user/1", option.Verb("PUT")).
+		Parameter("username", option.Default("ajones"))
+	r.EmbedResources("messages", []resource.Resource{resource.NewResource("Message")})
+	r.EmbedResource("manager", resource.NewResource("User"))
+	return r
+}
+
+func Test_ETagMustBeDeterministic(t *testing.T) {
+	//arrange
+	r1 := newETagTestResource()
+	r2 := newETagTestResource()
+
+	//act
+	etag1 := ETag(r1)
+	etag2 := ETag(r2)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(etag1, etag2)
+	a.Regexp(`^"[0-9a-f]{32}"$`, etag1)
+}
+
+func Test_ETagMustChangeWhenResourceChanges(t *testing.T) {
+	//arrange
+	r1 := newETagTestResource()
+	r2 := newETagTestResource()
+	r2.Links["updateUser"].Parameters[0].DefaultValue = "sanderson"
+
+	//act
+	etag1 := ETag(r1)
+	etag2 := ETag(r2)
+
+	//assert
+	a := assert.New(t)
+	a.NotEqual(etag1, etag2)
+}
+
+func Test_WeakETagMustBePrefixed(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+
+	//act
+	etag := WeakETag(r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("W/"+ETag(r), etag)
+}
+
+func Test_EvaluatePreconditionsMustReturnNotModifiedWhenIfNoneMatchMatches(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("If-None-Match", `"other", W/"abc"`)
+
+	//act
+	status, proceed := EvaluatePreconditions(req, `"abc"`, time.Time{})
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusNotModified, status)
+}
+
+func Test_EvaluatePreconditionsMustReturnPreconditionFailedWhenIfNoneMatchMatchesForUpdate(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	req.Header.Set("If-None-Match", "*")
+
+	//act
+	status, proceed := EvaluatePreconditions(req, `"abc"`, time.Time{})
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusPreconditionFailed, status)
+}
+
+func Test_EvaluatePreconditionsMustReturnPreconditionFailedWhenIfMatchDoesNotMatch(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	req.Header.Set("If-Match", `"other"`)
+
+	//act
+	status, proceed := EvaluatePreconditions(req, `"abc"`, time.Time{})
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusPreconditionFailed, status)
+}
+
+func Test_EvaluatePreconditionsMustUseStrongComparisonForIfMatch(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	req.Header.Set("If-Match", `W/"abc"`)
+
+	//act
+	_, proceed := EvaluatePreconditions(req, `"abc"`, time.Time{})
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+}
+
+func Test_EvaluatePreconditionsMustProceedWhenIfMatchMatches(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	req.Header.Set("If-Match", `"abc"`)
+
+	//act
+	status, proceed := EvaluatePreconditions(req, `"abc"`, time.Time{})
+
+	//assert
+	a := assert.New(t)
+	a.True(proceed)
+	a.Equal(http.StatusOK, status)
+}
+
+func Test_EvaluatePreconditionsMustReturnNotModifiedWhenNotModifiedSince(t *testing.T) {
+	//arrange
+	lastModified := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("If-Modified-Since", lastModified.Format(http.TimeFormat))
+
+	//act
+	status, proceed := EvaluatePreconditions(req, "", lastModified.Add(500*time.Millisecond))
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusNotModified, status)
+}
+
+func Test_EvaluatePreconditionsMustProceedWhenModifiedSince(t *testing.T) {
+	//arrange
+	lastModified := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("If-Modified-Since", lastModified.Format(http.TimeFormat))
+
+	//act
+	_, proceed := EvaluatePreconditions(req, "", lastModified.Add(time.Hour))
+
+	//assert
+	a := assert.New(t)
+	a.True(proceed)
+}
+
+func Test_EvaluatePreconditionsMustReturnPreconditionFailedWhenModifiedAfterIfUnmodifiedSince(t *testing.T) {
+	//arrange
+	lastModified := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodDelete, "/user/1", nil)
+	req.Header.Set("If-Unmodified-Since", lastModified.Format(http.TimeFormat))
+
+	//act
+	status, proceed := EvaluatePreconditions(req, "", lastModified.Add(time.Hour))
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusPreconditionFailed, status)
+}
+
+func Test_EvaluateResourcePreconditionsMustCompareResourceETag(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("If-None-Match", ETag(r, "application/json"))
+
+	//act
+	status, _ := EvaluateResourcePreconditions(req, r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusNotModified, status)
+}
+
+func Test_EvaluateResourcePreconditionsMustAcceptETagFromWrite(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	r.EmbedFunc("auditor", func(context.Context) (resource.Resource, error) {
+		return resource.NewResource("User"), nil
+	})
+	getRequest := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	getRequest.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+	_ = Write(w, getRequest, http.StatusOK, r, option.GenerateETag())
+
+	putRequest := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	putRequest.Header.Set("Accept", "application/xml")
+	putRequest.Header.Set("If-Match", w.Header().Get("ETag"))
+
+	//act
+	status, proceed := EvaluateResourcePreconditions(putRequest, r)
+
+	//assert
+	a := assert.New(t)
+	a.True(proceed)
+	a.Equal(http.StatusOK, status)
+}
+
+func Test_EvaluateResourcePreconditionsMustRejectETagOfChangedResource(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	getRequest := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	w := httptest.NewRecorder()
+	_ = Write(w, getRequest, http.StatusOK, r, option.GenerateETag())
+
+	changed := newETagTestResource()
+	changed.Data("Username", "someone else")
+	putRequest := httptest.NewRequest(http.MethodPut, "/user/1", nil)
+	putRequest.Header.Set("If-Match", w.Header().Get("ETag"))
+
+	//act
+	status, proceed := EvaluateResourcePreconditions(putRequest, changed)
+
+	//assert
+	a := assert.New(t)
+	a.False(proceed)
+	a.Equal(http.StatusPreconditionFailed, status)
+}
+
+func Test_WriteMustGenerateETag(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, r, option.GenerateWeakETag())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(WeakETag(r, "application/json"), w.Header().Get("ETag"))
+}
+
+func Test_WriteMustGenerateDifferentETagsPerContentType(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	jsonRecorder, xmlRecorder := httptest.NewRecorder(), httptest.NewRecorder()
+	jsonRequest := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	xmlRequest := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	xmlRequest.Header.Set("Accept", "application/xml")
+
+	//act
+	_ = Write(jsonRecorder, jsonRequest, http.StatusOK, r, option.GenerateETag())
+	_ = Write(xmlRecorder, xmlRequest, http.StatusOK, r, option.GenerateETag())
+
+	//assert
+	a := assert.New(t)
+	a.Equal(ETag(r, "application/xml"), xmlRecorder.Header().Get("ETag"))
+	a.NotEqual(jsonRecorder.Header().Get("ETag"), xmlRecorder.Header().Get("ETag"))
+}
+
+func Test_WriteMustReturnNotModifiedWhenETagMatches(t *testing.T) {
+	//arrange
+	r := newETagTestResource()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("If-None-Match", ETag(r, "application/json"))
+
+	//act
+	err := Write(w, req, http.StatusOK, r, option.GenerateETag())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.StatusNotModified, w.Code)
+	a.Empty(w.Body.String())
+}
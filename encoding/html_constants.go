@@ -1,6 +1,7 @@
 package encoding
 
-const resourceHtml = `{{define "resource"}}
+const resourceHtml = `{{define "constraints"}}{{if .Required}} required {{end}}{{if .Description}} title="{{.Description}}" {{end}}{{if .Min}} min="{{.Min}}" {{end}}{{if .Max}} max="{{.Max}}" {{end}}{{if .MinLength}} minlength="{{.MinLength}}" {{end}}{{if .MaxLength}} maxlength="{{.MaxLength}}" {{end}}{{if .Pattern}} pattern="{{.Pattern}}" {{end}}{{if .Multiple}} multiple {{end}}{{end}}
+{{define "resource"}}
 <table>
 	{{range $dataKey, $dataValue := .Values}}
 	<tr>
@@ -33,13 +34,14 @@ const resourceHtml = `{{define "resource"}}
 			{{if or (ne $link.Verb "GET") $link.Parameters}}
 				<td>
 					<form action={{$link.Href}} {{if eq $link.Verb "GET"}} method="GET" {{else}} method="POST" {{end}}>
+					<fieldset {{if $link.Disabled}} disabled {{end}}>
 						{{if and (ne $link.Verb "GET") (ne $link.Verb "POST")}}
 							<input type="hidden" name="_method" value="{{$link.Verb}}"></input>
 						{{end}}
 
 						{{range $parameter := $link.Parameters}}
 							{{ if $parameter.ListOfValues }}
-								<select name="{{$parameter.Name}}" placeholder="{{$parameter.Name}}" value="{{$parameter.DefaultValue}}">
+								<select name="{{$parameter.Name}}" placeholder="{{$parameter.Name}}" value="{{$parameter.DefaultValue}}" {{template "constraints" $parameter}}>
 									{{ range $value := SeparateListOfValues $parameter.ListOfValues }}
 										<option value="$value" {{ if eq $value $parameter.DefaultValue }} selected="selected" {{ end }}>
 											{{ $value }}
@@ -47,17 +49,22 @@ const resourceHtml = `{{define "resource"}}
 									{{ end }}
 								</select>
 							{{ else }}
-								<input name="{{$parameter.Name}}" placeholder="{{$parameter.Name}}"	value="{{$parameter.DefaultValue}}"></input>
+								<input name="{{$parameter.Name}}" placeholder="{{$parameter.Name}}"	value="{{$parameter.DefaultValue}}" type="{{ InputType $parameter }}" {{with InputStep $parameter}} step="{{.}}" {{end}}{{template "constraints" $parameter}}></input>
 							{{ end }}
 							<br>
 						{{end}}
 
 						<input type="submit" class="btn" value="{{$link.Verb}}"></input>	
+					</fieldset>
 					</form>
 			   </td>
 			{{else}}
 			  <td>
+				{{if $link.Disabled}}
+				<a id="{{$linkName}}" aria-disabled="true">{{$link.Href}}</a>
+				{{else}}
 				<a id="{{$linkName}}" href="{{$link.Href}}">{{$link.Href}}</a>
+				{{end}}
 				{{ range $templatedParameter := GetTemplatedParameters $link }}
 					<br>
                     <input id="{{$linkName}}_{{$templatedParameter}}" placeholder="{{$templatedParameter}}" oninput="OnUpdateTemplatedUrl({{$linkName}}, {{$link.Href}}, {{ GetTemplatedParameters $link }})"></input>
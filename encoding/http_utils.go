@@ -1,32 +1,115 @@
 package encoding
 
 import (
-	"encoding/xml"
+	"bytes"
+	"context"
 	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"io"
+	"net/http"
 	"strings"
 )
 
-func MarshalResource(headers map[string][]string, r resource.Resource) (string, string) {
+type encodeFunc func(w io.Writer) error
+
+// MarshalResource returns an empty body if an embedded resolver or the encoder fails; use MarshalResponse to get the error.
+func MarshalResource(headers map[string][]string, r resource.Resource, marshalOptions ...option.Option) (string, string) {
+	resolveErr := r.ResolveEmbedded(context.Background(), option.FindEmbedWorkersOption(marshalOptions))
+	contentType, encode := negotiateEncoder(headers, r, marshalOptions...)
+	if resolveErr != nil {
+		return "", contentType
+	}
+
+	buf := new(bytes.Buffer)
+	if err := encode(buf); err != nil {
+		return "", contentType
+	}
+	return buf.String(), contentType
+}
+
+func MarshalResponse(responseHeaders http.Header, requestHeaders map[string][]string, r resource.Resource, marshalOptions ...option.Option) (string, error) {
+	if err := r.ResolveEmbedded(context.Background(), option.FindEmbedWorkersOption(marshalOptions)); err != nil {
+		return "", err
+	}
+
+	contentType, encode, err := responseEncoder(responseHeaders, requestHeaders, r, marshalOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := encode(buf); err != nil {
+		return "", err
+	}
+
+	responseHeaders.Set("Content-Type", contentType)
+	if option.FindLinkHeaderOption(marshalOptions) {
+		SetLinkHeader(responseHeaders, r)
+	}
+
+	return buf.String(), nil
+}
+
+func responseEncoder(responseHeaders http.Header, requestHeaders map[string][]string, r resource.Resource, marshalOptions ...option.Option) (string, encodeFunc, error) {
+	acceptFormats, _ := requestHeaders["Accept"]
+	if linkHeader := responseHeaders.Get("Link"); linkHeader != "" && formatAccepted(acceptFormats, "text/html") {
+		merged, err := mergeLinkHeader(r, linkHeader)
+		if err != nil {
+			return "", nil, err
+		}
+		return "text/html", func(w io.Writer) error { return writeHtml(w, merged) }, nil
+	}
+
+	contentType, encode := negotiateEncoder(requestHeaders, r, marshalOptions...)
+	return contentType, encode, nil
+}
+
+func negotiateEncoder(headers map[string][]string, r resource.Resource, marshalOptions ...option.Option) (string, encodeFunc) {
+	acceptFormats, _ := headers["Accept"]
+	if IsProblem(r) && formatAccepted(acceptFormats, "application/vnd.error") {
+		r = VndErrorFromProblem(r)
+	} else if IsVndError(r) && formatAccepted(acceptFormats, "application/problem") {
+		r = ProblemFromVndError(r)
+	}
+
+	if IsProblem(r) {
+		contentType, encode := acceptedEncoder(headers, r, marshalOptions)
+		return problemContentType(contentType), encode
+	}
+
+	if IsVndError(r) {
+		contentType, encode := acceptedEncoder(headers, r, marshalOptions)
+		return vndErrorContentType(contentType), encode
+	}
+
+	return acceptedEncoder(headers, r, marshalOptions)
+}
+
+func acceptedEncoder(headers map[string][]string, r resource.Resource, marshalOptions []option.Option) (string, encodeFunc) {
 	acceptFormats, _ := headers["Accept"]
 
 	if formatAccepted(acceptFormats, "text/html") {
-		if v, err := MarshalHtml(r); err == nil {
-			return string(v), "text/html"
-		}
-		return "", "text/html"
+		return "text/html", func(w io.Writer) error { return writeHtml(w, r) }
 	}
 
-	if formatAccepted(acceptFormats, "application/xml") {
-		if v, err := MarshalXml(r); err == nil {
-			return xml.Header + string(v), "application/xml"
+	if formatAccepted(acceptFormats, "application/atom+xml") && !IsProblem(r) && !IsVndError(r) {
+		return "application/atom+xml", func(w io.Writer) error {
+			feed, err := newAtomFeed(r, marshalOptions)
+			if err != nil {
+				return err
+			}
+			return writeXml(w, feed)
 		}
-		return "", "application/xml"
 	}
 
-	if v, err := MarshalJson(r); err == nil {
-		return string(v), "application/json"
+	if formatAccepted(acceptFormats, "application/xml") || formatAccepted(acceptFormats, "application/problem+xml") || formatAccepted(acceptFormats, "application/vnd.error+xml") {
+		if IsVndError(r) {
+			return "application/xml", func(w io.Writer) error { return writeVndErrorXml(w, r) }
+		}
+		return "application/xml", func(w io.Writer) error { return writeXml(w, r) }
 	}
-	return "", "application/json"
+
+	return "application/json", func(w io.Writer) error { return writeJson(w, r) }
 }
 
 func formatAccepted(acceptFormats []string, format string) bool {
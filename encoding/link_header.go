@@ -0,0 +1,162 @@
+package encoding
+
+import (
+	"errors"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+func FormatLinkHeader(links resource.LinkData) string {
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		link := links[name]
+		value := "<" + link.Href + ">; rel=" + quotedParameter(name)
+
+		if link.Title != "" {
+			value += "; title=" + quotedParameter(link.Title)
+		}
+
+		if link.Type != "" {
+			value += "; type=" + quotedParameter(link.Type)
+		}
+
+		if link.IsTemplated {
+			value += "; templated=\"true\""
+		}
+
+		if link.Verb != "GET" {
+			value += "; verb=" + quotedParameter(link.Verb)
+		}
+
+		values = append(values, value)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func SetLinkHeader(headers http.Header, r resource.Resource) {
+	if len(r.Links) == 0 {
+		return
+	}
+	headers.Set("Link", FormatLinkHeader(r.Links))
+}
+
+func ParseLinkHeader(header string) (resource.LinkData, error) {
+	r := resource.NewResource()
+
+	for _, value := range splitLinkHeader(header) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(value, "<") || !strings.Contains(value, ">") {
+			return nil, errors.New("invalid link header value: " + value)
+		}
+
+		end := strings.Index(value, ">")
+		href := value[1:end]
+		parameters := parseLinkParameters(value[end+1:])
+
+		rel, ok := parameters["rel"]
+		if !ok {
+			return nil, errors.New("link header value is missing rel: " + value)
+		}
+
+		linkOptions := make([]option.Option, 0)
+		if title, ok := parameters["title"]; ok {
+			linkOptions = append(linkOptions, option.Title(title))
+		}
+		if mediaType, ok := parameters["type"]; ok {
+			linkOptions = append(linkOptions, option.MediaType(mediaType))
+		}
+		if parameters["templated"] == "true" {
+			linkOptions = append(linkOptions, option.Templated())
+		}
+		if verb, ok := parameters["verb"]; ok {
+			linkOptions = append(linkOptions, option.Verb(strings.ToUpper(verb)))
+		}
+
+		for _, name := range strings.Fields(rel) {
+			r.Link(name, href, linkOptions...)
+		}
+	}
+
+	return r.Links, nil
+}
+
+func splitLinkHeader(header string) []string {
+	values := make([]string, 0)
+	inHref, inQuotes := false, false
+	start := 0
+
+	for i, c := range header {
+		switch {
+		case c == '<' && !inQuotes:
+			inHref = true
+		case c == '>' && !inQuotes:
+			inHref = false
+		case c == '"' && !inHref:
+			inQuotes = !inQuotes
+		case c == ',' && !inHref && !inQuotes:
+			values = append(values, header[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(values, header[start:])
+}
+
+func parseLinkParameters(s string) map[string]string {
+	parameters := make(map[string]string)
+
+	for _, parameter := range splitLinkParameters(s) {
+		name, value, _ := strings.Cut(parameter, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
+			value = strings.ReplaceAll(value[1:len(value)-1], "\\\"", "\"")
+		}
+
+		if _, ok := parameters[name]; !ok {
+			parameters[name] = value
+		}
+	}
+
+	return parameters
+}
+
+func splitLinkParameters(s string) []string {
+	parameters := make([]string, 0)
+	inQuotes := false
+	start := 0
+
+	for i, c := range s {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == ';' && !inQuotes:
+			parameters = append(parameters, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parameters, s[start:])
+}
+
+func quotedParameter(s string) string {
+	return "\"" + strings.ReplaceAll(s, "\"", "\\\"") + "\""
+}
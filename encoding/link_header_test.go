@@ -0,0 +1,152 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func Test_FormatLinkHeaderMustOutputRelForEachLink(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Uri("/user?page=2")
+	r.Link("next", "/user?page=3")
+
+	//act
+	header := FormatLinkHeader(r.Links)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(`</user?page=3>; rel="next", </user?page=2>; rel="self"`, header)
+}
+
+func Test_FormatLinkHeaderMustOutputHints(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("getUser", "/user/{id}", option.Templated(), option.Title("Get User"), option.MediaType("application/json"))
+	r.Link("deleteUser", "/user/1", option.Verb("DELETE"))
+
+	//act
+	header := FormatLinkHeader(r.Links)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(`</user/1>; rel="deleteUser"; verb="DELETE", </user/{id}>; rel="getUser"; title="Get User"; type="application/json"; templated="true"`, header)
+}
+
+func Test_SetLinkHeaderMustNotSetHeaderWithoutLinks(t *testing.T) {
+	//arrange
+	headers := make(http.Header)
+
+	//act
+	SetLinkHeader(headers, resource.NewResource())
+
+	//assert
+	a := assert.New(t)
+	a.Empty(headers.Get("Link"))
+}
+
+func Test_ParseLinkHeaderMustReadLinks(t *testing.T) {
+	//arrange
+	header := `</user?page=3>; rel="next", </user/{id}>; rel=getUser; title="Get, User"; type="application/json"; templated="true", </user/1>; rel="deleteUser"; verb="DELETE"`
+
+	//act
+	links, err := ParseLinkHeader(header)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/user?page=3", links["next"].Href)
+	a.Equal("/user/{id}", links["getUser"].Href)
+	a.Equal("Get, User", links["getUser"].Title)
+	a.Equal("application/json", links["getUser"].Type)
+	a.True(links["getUser"].IsTemplated)
+	a.Equal("DELETE", links["deleteUser"].Verb)
+	a.Equal([]int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError}, links["deleteUser"].ResponseCodes)
+}
+
+func Test_ParseLinkHeaderMustAddLinkForEachRel(t *testing.T) {
+	//arrange
+	header := `</user?page=5>; rel="next last"`
+
+	//act
+	links, err := ParseLinkHeader(header)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/user?page=5", links["next"].Href)
+	a.Equal("/user?page=5", links["last"].Href)
+}
+
+func Test_ParseLinkHeaderMustReturnErrorForInvalidHeader(t *testing.T) {
+	//arrange
+	header := `/user; rel="next"`
+
+	//act
+	_, err := ParseLinkHeader(header)
+
+	//assert
+	a := assert.New(t)
+	a.Error(err)
+}
+
+func Test_ParseLinkHeaderMustReadFormattedHeader(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("createUser", "/user", option.Verb("POST"), option.Title("Create"))
+
+	//act
+	links, err := ParseLinkHeader(FormatLinkHeader(r.Links))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(*r.Links["createUser"], *links["createUser"])
+}
+
+func Test_MarshalHtmlWithLinkHeaderMustDisplayLinks(t *testing.T) {
+	//arrange
+	header := `</report.csv?page=2>; rel="next"`
+
+	//act
+	html, err := MarshalHtmlWithLinkHeader(resource.NewResource("Report"), header)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(html), `<a id="next" href="/report.csv?page=2">`)
+}
+
+func Test_MarshalResponseMustSetLinkHeaderIfRequested(t *testing.T) {
+	//arrange
+	r := resource.NewResource("User")
+	r.Uri("/user/1")
+	responseHeaders := make(http.Header)
+
+	//act
+	_, err := MarshalResponse(responseHeaders, map[string][]string{}, r, option.LinkHeader())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("application/json", responseHeaders.Get("Content-Type"))
+	a.Equal(`</user/1>; rel="self"`, responseHeaders.Get("Link"))
+}
+
+func Test_MarshalResponseMustDisplayLinksFromLinkHeaderInHtml(t *testing.T) {
+	//arrange
+	responseHeaders := http.Header{"Link": {`</report.csv?page=2>; rel="next"`}}
+	requestHeaders := map[string][]string{"Accept": {"text/html"}}
+
+	//act
+	html, err := MarshalResponse(responseHeaders, requestHeaders, resource.NewResource("Report"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("text/html", responseHeaders.Get("Content-Type"))
+	a.Contains(html, `<a id="next" href="/report.csv?page=2">`)
+}
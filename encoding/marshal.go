@@ -1,16 +1,25 @@
 package encoding
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"github.com/slyjeff/rest-resource"
-	"strings"
+	"io"
 )
 
 func MarshalJson(r resource.Resource) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := writeJson(buf, r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeJson(w io.Writer, r resource.Resource) error {
 	values, err := json.Marshal(r.Values)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	text := string(values)
@@ -18,49 +27,79 @@ func MarshalJson(r resource.Resource) ([]byte, error) {
 		text = "{}"
 	}
 
+	jw := &jsonWriter{w: w, empty: text == "{}"}
+	jw.write(text[:len(text)-1])
+
 	if len(r.Links) > 0 {
-		var links []byte
-		links, err = json.Marshal(r.Links)
+		links, err := json.Marshal(r.Links)
 		if err != nil {
-			return nil, err
+			return err
 		}
-
-		text = addToJson(text, "_links", string(links))
+		jw.field("_links")
+		jw.write(string(links))
 	}
 
 	if len(r.Embedded) > 0 {
-		embeddedJson := "{}"
+		jw.field("_embedded")
+		embeddedWriter := &jsonWriter{w: w, empty: true, err: jw.err}
+		embeddedWriter.write("{")
 		for name, embedded := range r.Embedded {
 			if embeddedResource, ok := embedded.(resource.Resource); ok {
-				if resourceJson, err := MarshalJson(embeddedResource); err == nil {
-					embeddedJson = addToJson(embeddedJson, name, string(resourceJson))
-				}
+				embeddedWriter.field(name)
+				embeddedWriter.resource(embeddedResource)
 			} else if embeddedResourceList, ok := embedded.([]resource.Resource); ok {
-				resourceJsonList := make([]string, 0)
-				for _, embeddedResource := range embeddedResourceList {
-					if resourceJson, err := MarshalJson(embeddedResource); err == nil {
-						resourceJsonList = append(resourceJsonList, string(resourceJson))
+				embeddedWriter.field(name)
+				embeddedWriter.write("[")
+				for i, embeddedResource := range embeddedResourceList {
+					if i > 0 {
+						embeddedWriter.write(",")
 					}
+					embeddedWriter.resource(embeddedResource)
 				}
-				var jsonArray = "[" + strings.Join(resourceJsonList, ",") + "]"
-				embeddedJson = addToJson(embeddedJson, name, jsonArray)
+				embeddedWriter.write("]")
 			}
 		}
+		embeddedWriter.write("}")
+		jw.err = embeddedWriter.err
+	}
 
-		text = addToJson(text, "_embedded", embeddedJson)
+	jw.write("}")
+	return jw.err
+}
+
+type jsonWriter struct {
+	w     io.Writer
+	empty bool
+	err   error
+}
+
+func (jw *jsonWriter) write(s string) {
+	if jw.err == nil {
+		_, jw.err = io.WriteString(jw.w, s)
 	}
+}
 
-	return []byte(text), nil
+func (jw *jsonWriter) field(name string) {
+	if !jw.empty {
+		jw.write(",")
+	}
+	jw.empty = false
+	jw.write("\"" + name + "\":")
 }
 
-func addToJson(json, name, value string) string {
-	nameValue := "\"" + name + "\":" + value
-	if json == "{}" {
-		return json[:len(json)-1] + nameValue + "}"
+func (jw *jsonWriter) resource(r resource.Resource) {
+	if jw.err == nil {
+		jw.err = writeJson(jw.w, r)
 	}
-	return json[:len(json)-1] + "," + nameValue + "}"
 }
 
 func MarshalXml(r resource.Resource) ([]byte, error) {
 	return xml.Marshal(r)
 }
+
+func writeXml(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,235 @@
+package encoding
+
+import (
+	"crypto/sha1"
+	"encoding/xml"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Id      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Links   []atomLink   `xml:"link"`
+	Content *atomContent `xml:"content,omitempty"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type atomContent struct {
+	Type     string `xml:"type,attr"`
+	InnerXml string `xml:",innerxml"`
+}
+
+func MarshalAtom(r resource.Resource, atomOptions ...option.Option) ([]byte, error) {
+	feed, err := newAtomFeed(r, atomOptions)
+	if err != nil {
+		return nil, err
+	}
+	return xml.Marshal(feed)
+}
+
+func newAtomFeed(r resource.Resource, atomOptions []option.Option) (atomFeed, error) {
+	titleField, _ := option.FindAtomTitleOption(atomOptions)
+	updatedField, _ := option.FindAtomUpdatedOption(atomOptions)
+	baseUri, _ := option.FindAtomBaseUriOption(atomOptions)
+
+	feed := atomFeed{
+		Xmlns:   atomNamespace,
+		Id:      atomId(selfHref(r), baseUri),
+		Title:   r.Schema,
+		Links:   newAtomLinks(r.Links),
+		Entries: make([]atomEntry, 0),
+	}
+
+	latest := atomUpdated(r, updatedField)
+	entryUpdated := make([]time.Time, 0)
+	for _, embedded := range sortedEmbeddedResources(r.Embedded) {
+		entry, err := newAtomEntry(embedded.resource, titleField, baseUri)
+		if err != nil {
+			return atomFeed{}, err
+		}
+
+		// entries without a self link would all share one id, so identify them by their position in the feed
+		if selfHref(embedded.resource) == "" {
+			entry.Id = fmt.Sprintf("%s#%s/%d", feed.Id, url.PathEscape(embedded.rel), embedded.index)
+		}
+
+		updated := atomUpdated(embedded.resource, updatedField)
+		if updated.After(latest) {
+			latest = updated
+		}
+		feed.Entries = append(feed.Entries, entry)
+		entryUpdated = append(entryUpdated, updated)
+	}
+
+	// atom:updated is required; fall back to a fixed time rather than now so the output stays stable between requests
+	if latest.IsZero() {
+		latest = time.Unix(0, 0)
+	}
+	feed.Updated = latest.UTC().Format(time.RFC3339)
+
+	for i, updated := range entryUpdated {
+		if updated.IsZero() {
+			updated = latest
+		}
+		feed.Entries[i].Updated = updated.UTC().Format(time.RFC3339)
+	}
+
+	return feed, nil
+}
+
+func newAtomEntry(r resource.Resource, titleField, baseUri string) (atomEntry, error) {
+	title := r.Schema
+	if value, ok := r.Values[titleField]; ok && titleField != "" {
+		title = atomText(value)
+	}
+
+	content, err := MarshalXml(r)
+	if err != nil {
+		return atomEntry{}, err
+	}
+
+	entry := atomEntry{
+		Id:      atomId(selfHref(r), baseUri),
+		Title:   title,
+		Links:   newAtomLinks(r.Links),
+		Content: &atomContent{"application/xml", string(content)},
+	}
+
+	return entry, nil
+}
+
+func atomUpdated(r resource.Resource, updatedField string) time.Time {
+	if value, ok := r.Values[updatedField]; ok && updatedField != "" {
+		return atomTime(value)
+	}
+	return time.Time{}
+}
+
+func atomId(href string, baseUri string) string {
+	if reference, err := url.Parse(href); err == nil {
+		if base, err := url.Parse(baseUri); err == nil {
+			reference = base.ResolveReference(reference)
+		}
+
+		if reference.IsAbs() {
+			return reference.String()
+		}
+	}
+
+	// atom:id must be an absolute IRI; without a base uri derive a stable name-based uuid from the href
+	hash := sha1.Sum([]byte(href))
+	hash[6] = (hash[6] & 0x0f) | 0x50
+	hash[8] = (hash[8] & 0x3f) | 0x80
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", hash[0:4], hash[4:6], hash[6:8], hash[8:10], hash[10:16])
+}
+
+func requestBaseUri(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := req.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + req.Host
+}
+
+func newAtomLinks(links resource.LinkData) []atomLink {
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	atomLinks := make([]atomLink, 0, len(names))
+	for _, name := range names {
+		atomLinks = append(atomLinks, atomLink{name, links[name].Href})
+	}
+
+	return atomLinks
+}
+
+type atomEmbeddedResource struct {
+	rel      string
+	index    int
+	resource resource.Resource
+}
+
+func sortedEmbeddedResources(embedded resource.EmbeddedResources) []atomEmbeddedResource {
+	names := make([]string, 0, len(embedded))
+	for name := range embedded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resources := make([]atomEmbeddedResource, 0)
+	for _, name := range names {
+		if embeddedResource, ok := embedded[name].(resource.Resource); ok {
+			resources = append(resources, atomEmbeddedResource{name, 0, embeddedResource})
+		} else if embeddedResourceList, ok := embedded[name].([]resource.Resource); ok {
+			for i, embeddedResource := range embeddedResourceList {
+				resources = append(resources, atomEmbeddedResource{name, i, embeddedResource})
+			}
+		}
+	}
+
+	return resources
+}
+
+func selfHref(r resource.Resource) string {
+	if self, ok := r.Links["self"]; ok {
+		return self.Href
+	}
+	return ""
+}
+
+func atomText(value interface{}) string {
+	if fd, ok := value.(resource.FormattedData); ok {
+		return fd.FormattedString()
+	}
+	return fmt.Sprint(value)
+}
+
+func atomTime(value interface{}) time.Time {
+	if fd, ok := value.(resource.FormattedData); ok {
+		value = fd.Value
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v
+	case *time.Time:
+		if v != nil {
+			return *v
+		}
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,217 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newAtomTestResource() resource.Resource {
+	r := resource.NewResource("UserList")
+	r.Uri("/user?page=2")
+	r.Link("next", "/user?page=3")
+	r.Link("prev", "/user?page=1")
+
+	user1 := resource.NewResource("User")
+	user1.Uri("/user/1")
+	user1.Data("Username", "ajones")
+	user1.Data("Modified", time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), option.Format("%v"))
+
+	user2 := resource.NewResource("User")
+	user2.Uri("/user/2")
+	user2.Data("Username", "sanderson")
+	user2.Data("Modified", "2024-04-01T10:00:00Z")
+
+	r.EmbedResources("users", []resource.Resource{user1, user2})
+	return r
+}
+
+func Test_MarshalAtomMustUseAbsoluteSelfLinkAsFeedId(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r, option.AtomBaseUri("http://localhost:8090"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<feed xmlns="http://www.w3.org/2005/Atom"><id>http://localhost:8090/user?page=2</id><title>UserList</title>`)
+}
+
+func Test_MarshalAtomMustUseUrnIdWithoutBaseUri(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r)
+	again, _ := MarshalAtom(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Regexp(`<feed xmlns="http://www.w3.org/2005/Atom"><id>urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}</id>`, string(x))
+	a.Equal(string(x), string(again))
+}
+
+func Test_MarshalAtomMustGiveEntriesWithoutSelfLinkDistinctIds(t *testing.T) {
+	//arrange
+	r := resource.NewResource("UserList")
+	r.Uri("/user")
+	user1 := resource.NewResource("User")
+	user1.Data("Username", "ajones")
+	user2 := resource.NewResource("User")
+	user2.Data("Username", "sanderson")
+	r.EmbedResources("users", []resource.Resource{user1, user2})
+
+	//act
+	x, err := MarshalAtom(r, option.AtomBaseUri("http://localhost:8090"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<entry><id>http://localhost:8090/user#users/0</id>`)
+	a.Contains(string(x), `<entry><id>http://localhost:8090/user#users/1</id>`)
+}
+
+func Test_MarshalAtomMustUseFeedUpdatedForEntriesWithoutUpdated(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+	user3 := resource.NewResource("User")
+	user3.Uri("/user/3")
+	r.EmbedResource("owner", user3)
+
+	//act
+	x, err := MarshalAtom(r, option.AtomUpdated("Modified"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<title>User</title><updated>2024-04-01T10:00:00Z</updated><link rel="self" href="/user/3"></link>`)
+	a.NotContains(string(x), "0001-01-01")
+}
+
+func Test_MarshalAtomMustBeStableWithoutUpdated(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r)
+	again, _ := MarshalAtom(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(string(x), string(again))
+	a.NotContains(string(x), "0001-01-01")
+}
+
+func Test_MarshalAtomMustOutputLinksIncludingPaging(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<link rel="next" href="/user?page=3"></link><link rel="prev" href="/user?page=1"></link><link rel="self" href="/user?page=2"></link>`)
+}
+
+func Test_MarshalAtomMustOutputEmbeddedResourcesAsEntries(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r, option.AtomTitle("Username"), option.AtomUpdated("Modified"), option.AtomBaseUri("http://localhost"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<entry><id>http://localhost/user/1</id><title>ajones</title><updated>2024-03-01T10:00:00Z</updated><link rel="self" href="/user/1"></link>`)
+	a.Contains(string(x), `<entry><id>http://localhost/user/2</id><title>sanderson</title><updated>2024-04-01T10:00:00Z</updated>`)
+}
+
+func Test_MarshalAtomMustUseLatestEntryAsFeedUpdated(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r, option.AtomUpdated("Modified"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<title>UserList</title><updated>2024-04-01T10:00:00Z</updated>`)
+}
+
+func Test_MarshalAtomMustUseSchemaAsEntryTitleIfNotConfigured(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r, option.AtomBaseUri("http://localhost"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<entry><id>http://localhost/user/1</id><title>User</title>`)
+}
+
+func Test_MarshalAtomMustIncludeEntryContent(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+
+	//act
+	x, err := MarshalAtom(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(string(x), `<content type="application/xml"><resource><Modified>2024-04-01T10:00:00Z</Modified><Username>sanderson</Username></resource></content>`)
+}
+
+func Test_MarshalResourceMustReturnAtomWhenAccepted(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+	headers := map[string][]string{"Accept": {"application/atom+xml"}}
+
+	//act
+	_, contentType := MarshalResource(headers, r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("application/atom+xml", contentType)
+}
+
+func Test_MarshalResourceMustPassAtomOptions(t *testing.T) {
+	//arrange
+	r := newAtomTestResource()
+	headers := map[string][]string{"Accept": {"application/atom+xml"}}
+
+	//act
+	x, _ := MarshalResource(headers, r, option.AtomTitle("Username"), option.AtomBaseUri("http://localhost"))
+
+	//assert
+	assert.Contains(t, x, `<entry><id>http://localhost/user/1</id><title>ajones</title>`)
+}
+
+func Test_WriteMustPassAtomOptionsAndRequestBaseUri(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/user?page=2", nil)
+	req.Header.Set("Accept", "application/atom+xml")
+
+	//act
+	err := Write(w, req, http.StatusOK, newAtomTestResource(), option.AtomUpdated("Modified"))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `<id>http://example.com/user?page=2</id><title>UserList</title><updated>2024-04-01T10:00:00Z</updated>`)
+}
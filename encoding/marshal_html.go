@@ -5,12 +5,48 @@ import (
 	"encoding/json"
 	"github.com/slyjeff/rest-resource"
 	"html/template"
+	"io"
 	"reflect"
 	"regexp"
 	"strings"
 )
 
+func MarshalHtmlWithLinkHeader(r resource.Resource, linkHeader string) ([]byte, error) {
+	r, err := mergeLinkHeader(r, linkHeader)
+	if err != nil {
+		return make([]byte, 0), err
+	}
+
+	return MarshalHtml(r)
+}
+
+func mergeLinkHeader(r resource.Resource, linkHeader string) (resource.Resource, error) {
+	links, err := ParseLinkHeader(linkHeader)
+	if err != nil {
+		return r, err
+	}
+
+	merged := make(resource.LinkData)
+	for name, link := range links {
+		merged[name] = link
+	}
+	for name, link := range r.Links {
+		merged[name] = link
+	}
+	r.Links = merged
+
+	return r, nil
+}
+
 func MarshalHtml(r resource.Resource) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := writeHtml(buf, r); err != nil {
+		return make([]byte, 0), err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeHtml(w io.Writer, r resource.Resource) error {
 	t := template.New("ResourceTemplate")
 
 	t = t.Funcs(template.FuncMap{
@@ -39,6 +75,29 @@ func MarshalHtml(r resource.Resource) ([]byte, error) {
 
 			return ""
 		},
+		"InputType": func(parameter resource.LinkParameter) string {
+			switch strings.ToLower(parameter.Format) {
+			case "email":
+				return "email"
+			case "date":
+				return "date"
+			case "date-time", "datetime":
+				return "datetime-local"
+			}
+
+			switch strings.ToLower(parameter.DataType) {
+			case "int", "int32", "int64", "number", "float", "float32", "float64":
+				return "number"
+			}
+			return "text"
+		},
+		"InputStep": func(parameter resource.LinkParameter) string {
+			switch strings.ToLower(parameter.DataType) {
+			case "float", "float32", "float64":
+				return "any"
+			}
+			return ""
+		},
 		"SeparateListOfValues": func(s string) []string {
 			return strings.Split(s, ",")
 		},
@@ -63,12 +122,8 @@ func MarshalHtml(r resource.Resource) ([]byte, error) {
 	var err error
 	t, err = t.Parse(resourceHtml)
 	if err != nil {
-		return make([]byte, 0), err
+		return err
 	}
 
-	buf := new(bytes.Buffer)
-	if err := t.Execute(buf, r); err != nil {
-		return make([]byte, 0), err
-	}
-	return buf.Bytes(), nil
+	return t.Execute(w, r)
 }
@@ -0,0 +1,51 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_MarshalHtmlMustAllowAnyStepForFloatParameters(t *testing.T) {
+	//arrange
+	r := resource.NewResource("Item")
+	r.Link("updateItem", "/item/1", option.Verb("PUT")).
+		Parameter("price", option.DataType("float64")).
+		Parameter("quantity", option.DataType("int"))
+
+	//act
+	html, err := MarshalHtml(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Regexp(`name="price"[^>]*type="number"\s+step="any"`, string(html))
+	a.NotRegexp(`name="quantity"[^>]*step=`, string(html))
+}
+
+func Test_MarshalHtmlMustRenderParameterConstraints(t *testing.T) {
+	//arrange
+	r := resource.NewResource("User")
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.Required(), option.MinLength(3), option.MaxLength(20), option.Pattern("[a-z]+")).
+		Parameter("age", option.DataType("int"), option.Min(18), option.Max(120)).
+		Parameter("email", option.ParameterFormat("email")).
+		Parameter("roles", option.Multiple())
+
+	//act
+	html, err := MarshalHtml(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Regexp(`name="username"[^>]*\srequired\s`, string(html))
+	a.Regexp(`name="username"[^>]*minlength="3"`, string(html))
+	a.Regexp(`name="username"[^>]*maxlength="20"`, string(html))
+	a.Regexp(`name="username"[^>]*pattern="\[a-z\](\+|&#43;)"`, string(html))
+	a.Regexp(`name="age"[^>]*min="18"`, string(html))
+	a.Regexp(`name="age"[^>]*max="120"`, string(html))
+	a.Regexp(`name="email"[^>]*type="email"`, string(html))
+	a.Regexp(`name="roles"[^>]*\smultiple\s`, string(html))
+	a.NotRegexp(`name="age"[^>]*required`, string(html))
+}
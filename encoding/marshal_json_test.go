@@ -201,6 +201,27 @@ func Test_MarshalJsonMustOutputLinkParameterListOfValues(t *testing.T) {
 	a.Equal(expectedJson, string(json))
 }
 
+func Test_MarshalJsonMustOutputLinkParameterConstraints(t *testing.T) {
+	//arrange
+	var r resource.Resource
+	r.Link("createUser", "/user").
+		Parameter("username", option.Required(), option.Description("Login name"), option.MinLength(3), option.MaxLength(20), option.Pattern(`\w+`), option.Multiple(), option.ParameterFormat("email")).
+		Parameter("age", option.Min(18), option.Max(120))
+
+	//act
+	json, err := MarshalJson(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	expectedJson := `{"_links":{"createUser":{"href":"/user","parameters":{"username":{"required":true,"description":"Login name","minLength":3,"maxLength":20,"pattern":"\\w+","multiple":true,"format":"email"},"age":{"min":"18","max":"120"}}}}}`
+	a.Equal(expectedJson, string(json))
+
+	unmarshalled, err := UnmarshalJson(json)
+	a.NoError(err)
+	a.Equal(r.Links["createUser"].Parameters, unmarshalled.Links["createUser"].Parameters)
+}
+
 func Test_MarshalJsonMustOutputDataType(t *testing.T) {
 	//arrange
 	var r resource.Resource
@@ -259,3 +280,18 @@ func Test_MarshalJsonMustOutputEmbeddedResourceList(t *testing.T) {
 	expectedJson := `{"id":1,"_embedded":{"children":[{"id":2},{"id":3}]}}`
 	a.Equal(expectedJson, string(json))
 }
+
+func Test_MarshalJsonMustOutputTitleAndTypeIfSet(t *testing.T) {
+	//arrange
+	var r resource.Resource
+	r.Link("report", "/report", option.Title("Report"), option.MediaType("text/csv"))
+
+	//act
+	json, err := MarshalJson(r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	expectedJson := `{"_links":{"report":{"href":"/report","title":"Report","type":"text/csv"}}}`
+	a.Equal(expectedJson, string(json))
+}
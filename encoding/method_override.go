@@ -0,0 +1,27 @@
+package encoding
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+var overridableMethods = []string{http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+func MethodOverride(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			method := req.Header.Get("X-HTTP-Method-Override")
+			if method == "" {
+				method = req.PostFormValue("_method")
+			}
+
+			method = strings.ToUpper(method)
+			if slices.Contains(overridableMethods, method) {
+				req.Method = method
+			}
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
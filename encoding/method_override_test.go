@@ -0,0 +1,87 @@
+package encoding
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serveWithMethodOverride(req *http.Request) string {
+	method := ""
+	handler := MethodOverride(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		method = req.Method
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return method
+}
+
+func newFormRequest(method, body string) *http.Request {
+	req := httptest.NewRequest(method, "/user/1", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func Test_MethodOverrideMustUseMethodFromForm(t *testing.T) {
+	//arrange
+	req := newFormRequest(http.MethodPost, "_method=PUT&username=ajones")
+
+	//act
+	method := serveWithMethodOverride(req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.MethodPut, method)
+	a.Equal("ajones", req.PostFormValue("username"))
+}
+
+func Test_MethodOverrideMustUseMethodFromHeader(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPost, "/user/1", nil)
+	req.Header.Set("X-HTTP-Method-Override", "delete")
+
+	//act
+	method := serveWithMethodOverride(req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.MethodDelete, method)
+}
+
+func Test_MethodOverrideMustOnlyOverridePost(t *testing.T) {
+	//arrange
+	req := newFormRequest(http.MethodGet, "_method=DELETE")
+	req.Header.Set("X-HTTP-Method-Override", "DELETE")
+
+	//act
+	method := serveWithMethodOverride(req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.MethodGet, method)
+}
+
+func Test_MethodOverrideMustIgnoreVerbsNotRendered(t *testing.T) {
+	//arrange
+	req := newFormRequest(http.MethodPost, "_method=CONNECT")
+
+	//act
+	method := serveWithMethodOverride(req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.MethodPost, method)
+}
+
+func Test_MethodOverrideMustLeavePostWithoutOverride(t *testing.T) {
+	//arrange
+	req := newFormRequest(http.MethodPost, "username=ajones")
+
+	//act
+	method := serveWithMethodOverride(req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.MethodPost, method)
+}
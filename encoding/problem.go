@@ -0,0 +1,85 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"strings"
+)
+
+const ProblemSchema = "Problem"
+
+type ConfigureProblem struct {
+	resource *resource.Resource
+}
+
+func NewProblem(status int) ConfigureProblem {
+	r := resource.NewResource(ProblemSchema)
+	r.Data("type", "about:blank")
+	r.Data("title", http.StatusText(status))
+	r.Data("status", status)
+	return ConfigureProblem{&r}
+}
+
+func NewValidationProblem(validationErrors ValidationErrors, link ...resource.Link) ConfigureProblem {
+	invalidParams := make([]resource.MappedData, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		name := fieldError.Field
+		if len(link) > 0 {
+			if parameter, ok := findParameter(link[0].Parameters, name); ok {
+				name = parameter.Name
+			}
+		}
+		invalidParams[i] = resource.MappedData{"name": name, "reason": fieldError.Message}
+	}
+
+	return NewProblem(http.StatusBadRequest).
+		Title("One or more parameters are invalid.").
+		Extension("invalid-params", invalidParams)
+}
+
+func (cp ConfigureProblem) Type(uri string) ConfigureProblem {
+	cp.resource.Data("type", uri)
+	return cp
+}
+
+func (cp ConfigureProblem) Title(title string) ConfigureProblem {
+	cp.resource.Data("title", title)
+	return cp
+}
+
+func (cp ConfigureProblem) Detail(detail string) ConfigureProblem {
+	cp.resource.Data("detail", detail)
+	return cp
+}
+
+func (cp ConfigureProblem) Instance(uri string) ConfigureProblem {
+	cp.resource.Data("instance", uri)
+	return cp
+}
+
+func (cp ConfigureProblem) Extension(name string, value interface{}) ConfigureProblem {
+	cp.resource.Data(name, value)
+	return cp
+}
+
+func (cp ConfigureProblem) Link(name string, href string, linkOptions ...option.Option) ConfigureProblem {
+	cp.resource.Link(name, href, linkOptions...)
+	return cp
+}
+
+func (cp ConfigureProblem) Resource() resource.Resource {
+	return *cp.resource
+}
+
+func IsProblem(r resource.Resource) bool {
+	return r.Schema == ProblemSchema
+}
+
+func problemContentType(contentType string) string {
+	mediaType, suffix, ok := strings.Cut(contentType, "/")
+	if !ok || mediaType != "application" || (suffix != "json" && suffix != "xml") {
+		return contentType
+	}
+	return "application/problem+" + suffix
+}
@@ -0,0 +1,95 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_NewProblemMustBuildProblemResource(t *testing.T) {
+	//act
+	r := NewProblem(http.StatusServiceUnavailable).
+		Type("https://example.com/problems/maintenance").
+		Detail("The service is down for maintenance.").
+		Instance("/user/1").
+		Extension("retryAfter", 30).
+		Link("retry", "/user/1").
+		Resource()
+
+	//assert
+	a := assert.New(t)
+	a.Equal("Problem", r.Schema)
+	a.Equal("https://example.com/problems/maintenance", r.Values["type"])
+	a.Equal("Service Unavailable", r.Values["title"])
+	a.Equal(http.StatusServiceUnavailable, r.Values["status"])
+	a.Equal("The service is down for maintenance.", r.Values["detail"])
+	a.Equal("/user/1", r.Values["instance"])
+	a.Equal(30, r.Values["retryAfter"])
+	a.Equal("/user/1", r.Links["retry"].Href)
+}
+
+func Test_NewValidationProblemMustMapFieldsToLinkParameterNames(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("userName")
+	validationErrors := ValidationErrors{{"username", "is required"}, {"body", "must be a valid json object"}}
+
+	//act
+	problem := NewValidationProblem(validationErrors, *r.Links["createUser"]).Resource()
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusBadRequest, problem.Values["status"])
+	a.Equal([]interface{}{
+		resource.MappedData{"name": "userName", "reason": "is required"},
+		resource.MappedData{"name": "body", "reason": "must be a valid json object"},
+	}, problem.Values["invalid-params"])
+}
+
+func Test_WriteMustUseProblemContentTypes(t *testing.T) {
+	tests := []struct {
+		accept      string
+		contentType string
+	}{
+		{"", "application/problem+json"},
+		{"application/problem+json", "application/problem+json"},
+		{"application/xml", "application/problem+xml"},
+		{"application/problem+xml", "application/problem+xml"},
+		{"text/html", "text/html"},
+	}
+
+	for _, test := range tests {
+		//arrange
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+		req.Header.Set("Accept", test.accept)
+
+		//act
+		err := Write(w, req, http.StatusNotFound, NewProblem(http.StatusNotFound).Resource())
+
+		//assert
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(test.contentType, w.Header().Get("Content-Type"), test.accept)
+	}
+}
+
+func Test_WriteMustOutputValidationProblemFieldsAsXml(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/user", nil)
+	req.Header.Set("Accept", "application/problem+xml")
+	problem := NewValidationProblem(ValidationErrors{{"username", "is required"}, {"age", "must be of type int"}}).Resource()
+
+	//act
+	err := Write(w, req, http.StatusBadRequest, problem)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), "<invalid-params><Value><name>username</name><reason>is required</reason></Value><Value><name>age</name><reason>must be of type int</reason></Value></invalid-params>")
+}
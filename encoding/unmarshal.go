@@ -1,8 +1,11 @@
 package encoding
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"strings"
 )
 
 func UnmarshalJson(jsonToUnmarshal []byte) (resource.Resource, error) {
@@ -13,8 +16,17 @@ func UnmarshalJson(jsonToUnmarshal []byte) (resource.Resource, error) {
 	}
 
 	for k, v := range result {
+		if k == "_embedded" {
+			if err := addEmbeddedToResource(&r, jsonToUnmarshal); err != nil {
+				return r, err
+			}
+			continue
+		}
+
 		if k == "_links" {
-			addLinksToResource(&r, v.(map[string]interface{}))
+			if links, ok := v.(map[string]interface{}); ok {
+				addLinksToResource(&r, links, findParameterOrder(jsonToUnmarshal))
+			}
 			continue
 		}
 		r.Data(k, v)
@@ -23,9 +35,187 @@ func UnmarshalJson(jsonToUnmarshal []byte) (resource.Resource, error) {
 	return r, nil
 }
 
-func addLinksToResource(resource *resource.Resource, links map[string]interface{}) {
+func addLinksToResource(resource *resource.Resource, links map[string]interface{}, parameterOrder map[string][]string) {
 	for k, v := range links {
-		link := v.(map[string]interface{})
-		resource.Link(k, link["href"].(string))
+		link, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		href, _ := link["href"].(string)
+		configureLink := resource.Link(k, href, findLinkOptions(link)...)
+
+		parameters, ok := link["parameters"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, name := range parameterOrder[k] {
+			parameter, _ := parameters[name].(map[string]interface{})
+			configureLink.Parameter(name, findParameterOptions(parameter)...)
+		}
+	}
+}
+
+func addEmbeddedToResource(r *resource.Resource, jsonToUnmarshal []byte) error {
+	var result struct {
+		Embedded map[string]json.RawMessage `json:"_embedded"`
+	}
+
+	if err := json.Unmarshal(jsonToUnmarshal, &result); err != nil {
+		return err
+	}
+
+	for name, rawEmbedded := range result.Embedded {
+		if len(rawEmbedded) == 0 || rawEmbedded[0] != '[' {
+			embeddedResource, err := UnmarshalJson(rawEmbedded)
+			if err != nil {
+				return err
+			}
+			r.EmbedResource(name, embeddedResource)
+			continue
+		}
+
+		var rawList []json.RawMessage
+		if err := json.Unmarshal(rawEmbedded, &rawList); err != nil {
+			return err
+		}
+
+		embeddedResources := make([]resource.Resource, len(rawList))
+		for i, rawResource := range rawList {
+			embeddedResource, err := UnmarshalJson(rawResource)
+			if err != nil {
+				return err
+			}
+			embeddedResources[i] = embeddedResource
+		}
+		r.EmbedResources(name, embeddedResources)
+	}
+
+	return nil
+}
+
+func findParameterOrder(jsonToUnmarshal []byte) map[string][]string {
+	var result struct {
+		Links map[string]struct {
+			Parameters json.RawMessage `json:"parameters"`
+		} `json:"_links"`
+	}
+
+	parameterOrder := make(map[string][]string)
+	if err := json.Unmarshal(jsonToUnmarshal, &result); err != nil {
+		return parameterOrder
+	}
+
+	for name, link := range result.Links {
+		parameterOrder[name] = findKeyOrder(link.Parameters)
+	}
+
+	return parameterOrder
+}
+
+func findKeyOrder(rawObject json.RawMessage) []string {
+	keys := make([]string, 0)
+	if len(rawObject) == 0 {
+		return keys
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawObject))
+	if _, err := decoder.Token(); err != nil {
+		return keys
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return keys
+		}
+
+		if key, ok := token.(string); ok {
+			keys = append(keys, key)
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return keys
+		}
+	}
+
+	return keys
+}
+
+func findLinkOptions(link map[string]interface{}) []option.Option {
+	linkOptions := make([]option.Option, 0)
+
+	if verb, ok := link["verb"].(string); ok {
+		linkOptions = append(linkOptions, option.Verb(verb))
+	}
+
+	if templated, ok := link["templated"].(bool); ok && templated {
+		linkOptions = append(linkOptions, option.Templated())
+	}
+
+	if title, ok := link["title"].(string); ok {
+		linkOptions = append(linkOptions, option.Title(title))
+	}
+
+	if mediaType, ok := link["type"].(string); ok {
+		linkOptions = append(linkOptions, option.MediaType(mediaType))
+	}
+
+	return linkOptions
+}
+
+func findParameterOptions(parameter map[string]interface{}) []option.Option {
+	parameterOptions := make([]option.Option, 0)
+
+	if defaultValue, ok := parameter["default"].(string); ok {
+		parameterOptions = append(parameterOptions, option.Default(defaultValue))
+	}
+
+	if listOfValues, ok := parameter["listOfValues"].(string); ok {
+		parameterOptions = append(parameterOptions, option.ListOfValues(strings.Split(listOfValues, ",")))
 	}
+
+	if dataType, ok := parameter["dataType"].(string); ok {
+		parameterOptions = append(parameterOptions, option.DataType(dataType))
+	}
+
+	if required, ok := parameter["required"].(bool); ok && required {
+		parameterOptions = append(parameterOptions, option.Required())
+	}
+
+	if description, ok := parameter["description"].(string); ok {
+		parameterOptions = append(parameterOptions, option.Description(description))
+	}
+
+	if min, ok := parameter["min"]; ok {
+		parameterOptions = append(parameterOptions, option.Min(min))
+	}
+
+	if max, ok := parameter["max"]; ok {
+		parameterOptions = append(parameterOptions, option.Max(max))
+	}
+
+	if minLength, ok := parameter["minLength"].(float64); ok {
+		parameterOptions = append(parameterOptions, option.MinLength(int(minLength)))
+	}
+
+	if maxLength, ok := parameter["maxLength"].(float64); ok {
+		parameterOptions = append(parameterOptions, option.MaxLength(int(maxLength)))
+	}
+
+	if pattern, ok := parameter["pattern"].(string); ok {
+		parameterOptions = append(parameterOptions, option.Pattern(pattern))
+	}
+
+	if multiple, ok := parameter["multiple"].(bool); ok && multiple {
+		parameterOptions = append(parameterOptions, option.Multiple())
+	}
+
+	if format, ok := parameter["format"].(string); ok {
+		parameterOptions = append(parameterOptions, option.ParameterFormat(format))
+	}
+
+	return parameterOptions
 }
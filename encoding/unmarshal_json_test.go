@@ -3,6 +3,7 @@ package encoding
 import (
 	"fmt"
 	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -77,3 +78,51 @@ func Test_UnmarshalJsonMustDecodeLink(t *testing.T) {
 	a.True(ok)
 	a.Equal("/user", link.Href)
 }
+
+func Test_UnmarshalJsonMustDecodeLinkDetails(t *testing.T) {
+	//arrange
+	originalResource := resource.NewResource()
+	originalResource.Link("searchUsers", "/user/{id}", option.Verb("POST"), option.Templated(), option.Title("Search")).
+		Parameter("username", option.Default("aj"), option.DataType("string")).
+		Parameter("status", option.ListOfValues([]string{"active", "inactive"}))
+	json, _ := MarshalJson(originalResource)
+
+	//act
+	unmarshalledResource, err := UnmarshalJson(json)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(*originalResource.Links["searchUsers"], *unmarshalledResource.Links["searchUsers"])
+}
+
+func Test_UnmarshalJsonMustDecodeEmbeddedResources(t *testing.T) {
+	//arrange
+	child := resource.NewResource()
+	child.Data("id", 2)
+	child.Link("self", "/child/2")
+
+	originalResource := resource.NewResource()
+	originalResource.EmbedResource("owner", child)
+	originalResource.EmbedResources("children", []resource.Resource{child, child})
+	json, _ := MarshalJson(originalResource)
+
+	//act
+	unmarshalledResource, err := UnmarshalJson(json)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+
+	_, ok := unmarshalledResource.Values["_embedded"]
+	a.False(ok, "_embedded shouldn't be added to values")
+
+	owner, ok := unmarshalledResource.Embedded["owner"].(resource.Resource)
+	a.True(ok)
+	a.Equal(float64(2), owner.Values["id"])
+	a.Equal("/child/2", owner.Links["self"].Href)
+
+	children, ok := unmarshalledResource.Embedded["children"].([]resource.Resource)
+	a.True(ok)
+	a.Len(children, 2)
+}
@@ -0,0 +1,293 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"io"
+	"mime"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+type ValidationErrors []FieldError
+
+func (ve ValidationErrors) Error() string {
+	messages := make([]string, len(ve))
+	for i, fieldError := range ve {
+		messages[i] = fieldError.Field + ": " + fieldError.Message
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+func (ve ValidationErrors) Resource() resource.Resource {
+	return NewValidationProblem(ve).Resource()
+}
+
+func Validate(link resource.Link, req *http.Request) (map[string]interface{}, error) {
+	received, err := readRequestValues(req)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	validationErrors := make(ValidationErrors, 0)
+
+	for _, parameter := range link.Parameters {
+		items := receivedItems(received[parameter.Name])
+		if len(items) == 0 {
+			if parameter.DefaultValue == "" {
+				if parameter.Required {
+					validationErrors = append(validationErrors, FieldError{parameter.Name, "is required"})
+				}
+				continue
+			}
+			items = []interface{}{parameter.DefaultValue}
+		}
+
+		if !parameter.Multiple {
+			items = items[:1]
+		}
+
+		convertedItems := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			converted, message := validateParameterValue(parameter, item)
+			if message != "" {
+				validationErrors = append(validationErrors, FieldError{parameter.Name, message})
+				break
+			}
+			convertedItems = append(convertedItems, converted)
+		}
+
+		if len(convertedItems) < len(items) {
+			continue
+		}
+
+		if parameter.Multiple {
+			values[parameter.Name] = convertedItems
+		} else {
+			values[parameter.Name] = convertedItems[0]
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return values, validationErrors
+	}
+
+	return values, nil
+}
+
+func readRequestValues(req *http.Request) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType == "application/json" && req.Body != nil {
+		content, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(content))
+
+		if len(bytes.TrimSpace(content)) > 0 {
+			if err := json.Unmarshal(content, &values); err != nil {
+				return nil, ValidationErrors{{"body", "must be a valid json object"}}
+			}
+		}
+	}
+
+	source := req.URL.Query()
+	if mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data" {
+		if err := parseForm(req, mediaType); err != nil {
+			return nil, ValidationErrors{{"body", "must be a valid " + mediaType + " body"}}
+		}
+		source = req.Form
+	}
+
+	for name, sourceValues := range source {
+		if _, ok := values[name]; !ok {
+			values[name] = sourceValues
+		}
+	}
+
+	return values, nil
+}
+
+func parseForm(req *http.Request, mediaType string) error {
+	if mediaType == "multipart/form-data" {
+		return req.ParseMultipartForm(32 << 20)
+	}
+	return req.ParseForm()
+}
+
+func receivedItems(value interface{}) []interface{} {
+	items := make([]interface{}, 0)
+
+	switch v := value.(type) {
+	case nil:
+	case []string:
+		for _, item := range v {
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if item != nil && item != "" {
+				items = append(items, item)
+			}
+		}
+	case string:
+		if v != "" {
+			items = append(items, v)
+		}
+	default:
+		items = append(items, v)
+	}
+
+	return items
+}
+
+var uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+func validateParameterValue(parameter resource.LinkParameter, value interface{}) (interface{}, string) {
+	converted, ok := convertParameterValue(value, parameter.DataType)
+	if !ok {
+		return nil, "must be of type " + parameter.DataType
+	}
+
+	if parameter.ListOfValues != "" {
+		listOfValues := strings.Split(parameter.ListOfValues, ",")
+		if !slices.Contains(listOfValues, fmt.Sprintf("%v", converted)) {
+			return nil, "must be one of: " + strings.Join(listOfValues, ", ")
+		}
+	}
+
+	var number float64
+	isNumber := true
+	switch n := converted.(type) {
+	case int:
+		number = float64(n)
+	case float64:
+		number = n
+	default:
+		isNumber = false
+	}
+
+	if min, err := strconv.ParseFloat(parameter.Min, 64); err == nil && isNumber && number < min {
+		return nil, "must be at least " + parameter.Min
+	}
+
+	if max, err := strconv.ParseFloat(parameter.Max, 64); err == nil && isNumber && number > max {
+		return nil, "must be at most " + parameter.Max
+	}
+
+	s, isString := converted.(string)
+	if !isString {
+		return converted, ""
+	}
+
+	length := utf8.RuneCountInString(s)
+	if parameter.MinLength > 0 && length < parameter.MinLength {
+		return nil, fmt.Sprintf("must be at least %d characters", parameter.MinLength)
+	}
+
+	if parameter.MaxLength > 0 && length > parameter.MaxLength {
+		return nil, fmt.Sprintf("must be at most %d characters", parameter.MaxLength)
+	}
+
+	if parameter.Pattern != "" {
+		if matched, err := regexp.MatchString("^(?:"+parameter.Pattern+")$", s); err != nil || !matched {
+			return nil, "must match pattern " + parameter.Pattern
+		}
+	}
+
+	if !isValidFormat(s, parameter.Format) {
+		return nil, "must be a valid " + parameter.Format
+	}
+
+	return converted, ""
+}
+
+func isValidFormat(s string, format string) bool {
+	switch strings.ToLower(format) {
+	case "email":
+		address, err := mail.ParseAddress(s)
+		return err == nil && address.Address == s
+	case "date":
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	case "date-time", "datetime":
+		_, ok := parseDateTime(s)
+		return ok
+	case "uuid":
+		return uuidPattern.MatchString(s)
+	default:
+		return true
+	}
+}
+
+var dateTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02T15:04:05"}
+
+func parseDateTime(s string) (time.Time, bool) {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func convertParameterValue(value interface{}, dataType string) (interface{}, bool) {
+	s, isString := value.(string)
+
+	switch strings.ToLower(dataType) {
+	case "int", "int32", "int64", "number":
+		if isString {
+			i, err := strconv.Atoi(s)
+			return i, err == nil
+		}
+		if f, ok := value.(float64); ok && f == float64(int(f)) {
+			return int(f), true
+		}
+		return nil, false
+	case "float", "float32", "float64":
+		if isString {
+			f, err := strconv.ParseFloat(s, 64)
+			return f, err == nil
+		}
+		f, ok := value.(float64)
+		return f, ok
+	case "bool", "boolean":
+		if isString {
+			b, err := strconv.ParseBool(s)
+			return b, err == nil
+		}
+		b, ok := value.(bool)
+		return b, ok
+	case "", "string":
+		if isString {
+			return s, true
+		}
+		if _, ok := value.(float64); ok {
+			return fmt.Sprintf("%v", value), true
+		}
+		if _, ok := value.(bool); ok {
+			return fmt.Sprintf("%v", value), true
+		}
+		return nil, false
+	default:
+		return value, true
+	}
+}
@@ -0,0 +1,199 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newValidationTestLink() resource.Link {
+	r := resource.NewResource()
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.Required()).
+		Parameter("age", option.DataType("int")).
+		Parameter("isActive", option.DataType("bool"), option.Default("true")).
+		Parameter("role", option.ListOfValues([]string{"admin", "user"}), option.Default("user"))
+	return *r.Links["createUser"]
+}
+
+func Test_ValidateMustReadJsonBodyAndApplyDefaults(t *testing.T) {
+	//arrange
+	body := `{"username":"ajones","age":42}`
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	//act
+	values, err := Validate(newValidationTestLink(), req)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(map[string]interface{}{"username": "ajones", "age": 42, "isActive": true, "role": "user"}, values)
+
+	restored, _ := io.ReadAll(req.Body)
+	a.Equal(body, string(restored))
+}
+
+func Test_ValidateMustReadFormValues(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader("username=ajones&age=42&isActive=false&role=admin"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	//act
+	values, err := Validate(newValidationTestLink(), req)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(map[string]interface{}{"username": "ajones", "age": 42, "isActive": false, "role": "admin"}, values)
+}
+
+func Test_ValidateMustReturnFieldErrorForMalformedForm(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader("username=%zz"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	//act
+	_, err := Validate(newValidationTestLink(), req)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal("body", validationErrors[0].Field)
+}
+
+func Test_ValidateMustReturnFieldErrorForMalformedMultipart(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader("not multipart"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=xyz")
+
+	//act
+	_, err := Validate(newValidationTestLink(), req)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{{"body", "must be a valid multipart/form-data body"}}, validationErrors)
+}
+
+func Test_ValidateMustReadQueryForGet(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("searchUsers", "/user").
+		Parameter("username").
+		Parameter("page", option.DataType("int"), option.Default("1"))
+	req := httptest.NewRequest(http.MethodGet, "/user?username=ajones", nil)
+
+	//act
+	values, err := Validate(*r.Links["searchUsers"], req)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(map[string]interface{}{"username": "ajones", "page": 1}, values)
+}
+
+func Test_ValidateMustReturnFieldErrors(t *testing.T) {
+	//arrange
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader(`{"age":"old","isActive":"maybe","role":"owner"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	//act
+	_, err := Validate(newValidationTestLink(), req)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{
+		{"username", "is required"},
+		{"age", "must be of type int"},
+		{"isActive", "must be of type bool"},
+		{"role", "must be one of: admin, user"},
+	}, validationErrors)
+}
+
+func Test_ValidationErrorsMustConvertToResource(t *testing.T) {
+	//arrange
+	validationErrors := ValidationErrors{{"username", "is required"}}
+
+	//act
+	r := validationErrors.Resource()
+
+	//assert
+	a := assert.New(t)
+	a.Equal("Problem", r.Schema)
+	a.Equal(http.StatusBadRequest, r.Values["status"])
+	a.Equal([]interface{}{resource.MappedData{"name": "username", "reason": "is required"}}, r.Values["invalid-params"])
+}
+
+func Test_ValidateMustCheckParameterConstraints(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.MinLength(3), option.MaxLength(8), option.Pattern("[a-z]+")).
+		Parameter("age", option.DataType("int"), option.Min(18), option.Max(120)).
+		Parameter("email", option.ParameterFormat("email")).
+		Parameter("id", option.ParameterFormat("uuid"))
+	body := `{"username":"AJ","age":12,"email":"not an email","id":"1234"}`
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	//act
+	_, err := Validate(*r.Links["createUser"], req)
+
+	//assert
+	a := assert.New(t)
+	var validationErrors ValidationErrors
+	a.ErrorAs(err, &validationErrors)
+	a.Equal(ValidationErrors{
+		{"username", "must be at least 3 characters"},
+		{"age", "must be at least 18"},
+		{"email", "must be a valid email"},
+		{"id", "must be a valid uuid"},
+	}, validationErrors)
+}
+
+func Test_ValidateMustAcceptDateTimeLocalValues(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("createEvent", "/event", option.Verb("POST")).
+		Parameter("startsAt", option.ParameterFormat("date-time")).
+		Parameter("endsAt", option.ParameterFormat("date-time"))
+	req := httptest.NewRequest(http.MethodPost, "/event", strings.NewReader("startsAt=2024-04-01T10%3A00&endsAt=2024-04-01T11%3A30%3A15"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	//act
+	values, err := Validate(*r.Links["createEvent"], req)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("2024-04-01T10:00", values["startsAt"])
+	a.Equal("2024-04-01T11:30:15", values["endsAt"])
+}
+
+func Test_ValidateMustCollectMultipleValues(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("searchUsers", "/user").
+		Parameter("role", option.Multiple(), option.ListOfValues([]string{"admin", "user", "guest"})).
+		Parameter("page", option.DataType("int"))
+	req := httptest.NewRequest(http.MethodGet, "/user?role=admin&role=guest&page=2&page=3", nil)
+
+	//act
+	values, err := Validate(*r.Links["searchUsers"], req)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal([]interface{}{"admin", "guest"}, values["role"])
+	a.Equal(2, values["page"])
+}
@@ -0,0 +1,238 @@
+package encoding
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const VndErrorSchema = "VndError"
+
+type ConfigureVndError struct {
+	resource *resource.Resource
+}
+
+func NewVndError(message string) ConfigureVndError {
+	r := resource.NewResource(VndErrorSchema)
+	r.Data("message", message)
+	return ConfigureVndError{&r}
+}
+
+func (cv ConfigureVndError) Logref(logref interface{}) ConfigureVndError {
+	cv.resource.Data("logref", logref)
+	return cv
+}
+
+func (cv ConfigureVndError) Path(path string) ConfigureVndError {
+	cv.resource.Data("path", path)
+	return cv
+}
+
+func (cv ConfigureVndError) Help(href string) ConfigureVndError {
+	cv.resource.Link("help", href)
+	return cv
+}
+
+func (cv ConfigureVndError) Describes(href string) ConfigureVndError {
+	cv.resource.Link("describes", href)
+	return cv
+}
+
+func (cv ConfigureVndError) About(href string) ConfigureVndError {
+	cv.resource.Link("about", href)
+	return cv
+}
+
+func (cv ConfigureVndError) Error(nested resource.Resource) ConfigureVndError {
+	errors, _ := cv.resource.Embedded["errors"].([]resource.Resource)
+	cv.resource.EmbedResources("errors", append(errors, nested))
+	return cv
+}
+
+func (cv ConfigureVndError) Resource() resource.Resource {
+	return *cv.resource
+}
+
+func IsVndError(r resource.Resource) bool {
+	return r.Schema == VndErrorSchema
+}
+
+func VndErrorFromProblem(problem resource.Resource) resource.Resource {
+	message := problemString(problem, "detail")
+	if message == "" {
+		message = problemString(problem, "title")
+	}
+
+	vndError := NewVndError(message)
+
+	if logref := problemString(problem, "logref"); logref != "" {
+		vndError.Logref(logref)
+	} else if status, ok := problem.Values["status"]; ok {
+		vndError.Logref(status)
+	}
+
+	if instance := problemString(problem, "instance"); instance != "" {
+		vndError.About(instance)
+	}
+
+	if problemType := problemString(problem, "type"); problemType != "" && problemType != "about:blank" {
+		vndError.Help(problemType)
+	}
+
+	for name, link := range problem.Links {
+		if _, ok := vndError.resource.Links[name]; !ok {
+			linkCopy := *link
+			vndError.resource.Links[name] = &linkCopy
+		}
+	}
+
+	for _, invalidParam := range problemInvalidParams(problem) {
+		nested := NewVndError(fmt.Sprintf("%v", invalidParam["reason"])).
+			Path("/" + fmt.Sprintf("%v", invalidParam["name"]))
+		vndError.Error(nested.Resource())
+	}
+
+	return vndError.Resource()
+}
+
+func ProblemFromVndError(vndError resource.Resource) resource.Resource {
+	status := http.StatusInternalServerError
+	if logref, ok := vndError.Values["logref"].(int); ok && http.StatusText(logref) != "" {
+		status = logref
+	}
+
+	problem := NewProblem(status).Detail(problemString(vndError, "message"))
+
+	if help, ok := vndError.Links["help"]; ok {
+		problem.Type(help.Href)
+	}
+
+	if about, ok := vndError.Links["about"]; ok {
+		problem.Instance(about.Href)
+	}
+
+	for name, link := range vndError.Links {
+		if name != "help" && name != "about" {
+			linkCopy := *link
+			problem.resource.Links[name] = &linkCopy
+		}
+	}
+
+	nestedErrors, _ := vndError.Embedded["errors"].([]resource.Resource)
+	if len(nestedErrors) > 0 {
+		invalidParams := make([]resource.MappedData, len(nestedErrors))
+		for i, nested := range nestedErrors {
+			name := strings.TrimPrefix(problemString(nested, "path"), "/")
+			invalidParams[i] = resource.MappedData{"name": name, "reason": problemString(nested, "message")}
+		}
+		problem.Extension("invalid-params", invalidParams)
+	}
+
+	return problem.Resource()
+}
+
+func problemString(r resource.Resource, name string) string {
+	value, ok := r.Values[name]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func problemInvalidParams(problem resource.Resource) []resource.MappedData {
+	invalidParams := make([]resource.MappedData, 0)
+
+	values, _ := problem.Values["invalid-params"].([]interface{})
+	for _, value := range values {
+		if invalidParam, ok := value.(resource.MappedData); ok {
+			invalidParams = append(invalidParams, invalidParam)
+		}
+	}
+
+	return invalidParams
+}
+
+func writeVndErrorXml(w io.Writer, vndError resource.Resource) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	e := xml.NewEncoder(w)
+	if err := encodeVndErrorXml(e, vndError, ""); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+func encodeVndErrorXml(e *xml.Encoder, vndError resource.Resource, rel string) error {
+	start := xml.StartElement{Name: xml.Name{Local: "resource"}}
+	if rel != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "rel"}, Value: rel})
+	}
+	if logref, ok := vndError.Values["logref"]; ok {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "logref"}, Value: fmt.Sprintf("%v", logref)})
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(vndError.Links) {
+		link := vndError.Links[name]
+		linkElement := xml.StartElement{Name: xml.Name{Local: "link"}, Attr: []xml.Attr{
+			{Name: xml.Name{Local: "rel"}, Value: name},
+			{Name: xml.Name{Local: "href"}, Value: link.Href},
+		}}
+		if link.Title != "" {
+			linkElement.Attr = append(linkElement.Attr, xml.Attr{Name: xml.Name{Local: "title"}, Value: link.Title})
+		}
+		if err := e.EncodeElement("", linkElement); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(vndError.Values) {
+		if name == "logref" {
+			continue
+		}
+		if err := e.EncodeElement(problemString(vndError, name), xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(vndError.Embedded) {
+		nestedErrors, _ := vndError.Embedded[name].([]resource.Resource)
+		if nested, ok := vndError.Embedded[name].(resource.Resource); ok {
+			nestedErrors = []resource.Resource{nested}
+		}
+
+		for _, nested := range nestedErrors {
+			if err := encodeVndErrorXml(e, nested, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func vndErrorContentType(contentType string) string {
+	mediaType, suffix, ok := strings.Cut(contentType, "/")
+	if !ok || mediaType != "application" || (suffix != "json" && suffix != "xml") {
+		return contentType
+	}
+	return "application/vnd.error+" + suffix
+}
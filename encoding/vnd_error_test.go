@@ -0,0 +1,137 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_NewVndErrorMustBuildVndErrorResource(t *testing.T) {
+	//act
+	r := NewVndError("Validation failed").
+		Logref(42).
+		Path("/user").
+		Help("http://example.com/help").
+		Describes("/user/1").
+		Error(NewVndError("is required").Path("/username").Resource()).
+		Error(NewVndError("must be a valid email").Path("/email").Resource()).
+		Resource()
+
+	//assert
+	a := assert.New(t)
+	json, err := MarshalJson(r)
+	a.NoError(err)
+	expected := `{"logref":42,"message":"Validation failed","path":"/user",` +
+		`"_links":{"describes":{"href":"/user/1"},"help":{"href":"http://example.com/help"}},` +
+		`"_embedded":{"errors":[{"message":"is required","path":"/username"},{"message":"must be a valid email","path":"/email"}]}}`
+	a.Equal(expected, string(json))
+}
+
+func Test_VndErrorFromProblemMustConvertProblem(t *testing.T) {
+	//arrange
+	problem := NewValidationProblem(ValidationErrors{{"username", "is required"}}).
+		Type("http://example.com/problems/validation").
+		Detail("The user could not be created.").
+		Instance("/user").
+		Resource()
+
+	//act
+	r := VndErrorFromProblem(problem)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("VndError", r.Schema)
+	a.Equal("The user could not be created.", r.Values["message"])
+	a.Equal(http.StatusBadRequest, r.Values["logref"])
+	a.Equal("http://example.com/problems/validation", r.Links["help"].Href)
+	a.Equal("/user", r.Links["about"].Href)
+	nested := r.Embedded["errors"].([]resource.Resource)
+	a.Len(nested, 1)
+	a.Equal("is required", nested[0].Values["message"])
+	a.Equal("/username", nested[0].Values["path"])
+}
+
+func Test_ProblemFromVndErrorMustConvertVndError(t *testing.T) {
+	//arrange
+	vndError := NewVndError("User not found.").
+		Logref(http.StatusNotFound).
+		About("/user/5").
+		Resource()
+
+	//act
+	r := ProblemFromVndError(vndError)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("Problem", r.Schema)
+	a.Equal(http.StatusNotFound, r.Values["status"])
+	a.Equal("User not found.", r.Values["detail"])
+	a.Equal("/user/5", r.Values["instance"])
+}
+
+func Test_WriteMustNegotiateBetweenProblemAndVndError(t *testing.T) {
+	tests := []struct {
+		accept      string
+		r           resource.Resource
+		contentType string
+		body        string
+	}{
+		{"application/vnd.error+json", NewProblem(http.StatusNotFound).Detail("User not found.").Resource(), "application/vnd.error+json", `{"logref":404,"message":"User not found."}`},
+		{"application/problem+json", NewVndError("User not found.").Logref(404).Resource(), "application/problem+json", `{"detail":"User not found.","status":404,"title":"Not Found","type":"about:blank"}`},
+		{"application/json", NewVndError("User not found.").Resource(), "application/vnd.error+json", `{"message":"User not found."}`},
+	}
+
+	for _, test := range tests {
+		//arrange
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/user/5", nil)
+		req.Header.Set("Accept", test.accept)
+
+		//act
+		err := Write(w, req, http.StatusNotFound, test.r)
+
+		//assert
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(test.contentType, w.Header().Get("Content-Type"), test.accept)
+		a.Equal(test.body, w.Body.String(), test.accept)
+	}
+}
+
+func Test_WriteMustRenderVndErrorAsXml(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/5", nil)
+	req.Header.Set("Accept", "application/vnd.error+xml")
+
+	//act
+	err := Write(w, req, http.StatusNotFound, NewVndError("User not found.").Resource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("application/vnd.error+xml", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), "<message>User not found.</message>")
+}
+
+func Test_WriteMustRenderNestedVndErrorsAndLinksAsXml(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/user", nil)
+	req.Header.Set("Accept", "application/vnd.error+xml")
+	vndError := NewVndError("Validation failed.").
+		Logref(42).
+		Help("/help/validation").
+		Error(NewVndError("is required").Path("/username").Resource()).
+		Resource()
+
+	//act
+	err := Write(w, req, http.StatusBadRequest, vndError)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `<resource logref="42"><link rel="help" href="/help/validation"></link><message>Validation failed.</message><resource rel="errors"><message>is required</message><path>/username</path></resource></resource>`)
+}
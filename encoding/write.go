@@ -0,0 +1,122 @@
+package encoding
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func Write(w http.ResponseWriter, req *http.Request, status int, r resource.Resource, writeOptions ...option.Option) error {
+	// generated etags hash the resource as the handler built it, so EvaluateResourcePreconditions can reproduce them
+	model := r
+
+	if option.FindExpandEmbeddedOption(writeOptions) {
+		r.SelectEmbedded(resource.EmbedsFromQuery(req.URL.Query()))
+	}
+
+	if err := r.ResolveEmbedded(req.Context(), option.FindEmbedWorkersOption(writeOptions)); err != nil {
+		return err
+	}
+
+	if authorizer, policy, ok := resource.AuthorizerFromContext(req.Context()); ok {
+		r.Authorize(authorizer, policy)
+	}
+
+	if option.FindSparseFieldsOption(writeOptions) {
+		r.SelectFields(resource.FieldsFromQuery(req.URL.Query()))
+	}
+
+	if _, ok := option.FindAtomBaseUriOption(writeOptions); !ok {
+		writeOptions = append(writeOptions, option.AtomBaseUri(requestBaseUri(req)))
+	}
+
+	headers := w.Header()
+	contentType, encode, err := responseEncoder(headers, req.Header, r, writeOptions...)
+	if err != nil {
+		return err
+	}
+
+	headers.Set("Content-Type", contentType)
+	headers.Add("Vary", "Accept")
+
+	if self, ok := r.Links["self"]; ok {
+		if status == http.StatusCreated {
+			headers.Set("Location", self.Href)
+		}
+		headers.Set("Allow", strings.Join(allowedVerbs(r.Links, self.Href), ", "))
+	}
+
+	etag, hasETag := option.FindETagOption(writeOptions)
+	if generate, ok := option.FindGenerateETagOption(writeOptions); ok && !hasETag {
+		etag, hasETag = ETag(model, contentType), true
+		if generate == "weak" {
+			etag = WeakETag(model, contentType)
+		}
+	}
+
+	if hasETag {
+		headers.Set("ETag", etag)
+
+		if status == http.StatusOK && (req.Method == http.MethodGet || req.Method == http.MethodHead) {
+			if preconditionStatus, proceed := EvaluatePreconditions(req, etag, time.Time{}); !proceed {
+				headers.Del("Content-Type")
+				w.WriteHeader(preconditionStatus)
+				return nil
+			}
+		}
+	}
+
+	if option.FindLinkHeaderOption(writeOptions) {
+		SetLinkHeader(headers, r)
+	}
+
+	if !bodyAllowed(status) {
+		headers.Del("Content-Type")
+		headers.Del("Content-Length")
+		w.WriteHeader(status)
+		return nil
+	}
+
+	if req.Method == http.MethodHead {
+		counter := &countingWriter{}
+		if err := encode(counter); err != nil {
+			return err
+		}
+		headers.Set("Content-Length", strconv.Itoa(counter.n))
+		w.WriteHeader(status)
+		return nil
+	}
+
+	w.WriteHeader(status)
+	return encode(w)
+}
+
+func bodyAllowed(status int) bool {
+	return status >= http.StatusOK && status != http.StatusNoContent && status != http.StatusNotModified
+}
+
+type countingWriter struct {
+	n int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	cw.n += len(p)
+	return len(p), nil
+}
+
+func allowedVerbs(links resource.LinkData, href string) []string {
+	verbs := []string{http.MethodGet, http.MethodHead}
+	for _, link := range links {
+		if link.Href != href || link.Disabled || slices.Contains(verbs, link.Verb) {
+			continue
+		}
+		verbs = append(verbs, link.Verb)
+	}
+
+	slices.Sort(verbs[2:])
+	return verbs
+}
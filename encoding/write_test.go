@@ -0,0 +1,345 @@
+package encoding
+
+import (
+	"context"
+	"errors"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func newWriteTestResource() resource.Resource {
+	r := resource.NewResource("User")
+	r.Uri("/user/1")
+	r.Data("Username", "ajones")
+	r.Link("updateUser", "/user/1", option.Verb("PUT"))
+	r.Link("deleteUser", "/user/1", option.Verb("DELETE"))
+	r.Link("createUser", "/user", option.Verb("POST"))
+	return r
+}
+
+func Test_WriteMustWriteStatusAndBody(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	r := newWriteTestResource()
+
+	//act
+	err := Write(w, req, http.StatusOK, r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.StatusOK, w.Code)
+	a.Contains(w.Body.String(), `"Username":"ajones"`)
+	a.Equal("application/json", w.Header().Get("Content-Type"))
+	a.Equal("Accept", w.Header().Get("Vary"))
+}
+
+func Test_WriteMustNegotiateContentType(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req.Header.Set("Accept", "application/xml")
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("application/xml", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), "<Username>ajones</Username>")
+}
+
+func Test_WriteMustSetLocationOnCreated(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/user", nil)
+
+	//act
+	err := Write(w, req, http.StatusCreated, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("/user/1", w.Header().Get("Location"))
+}
+
+func Test_WriteMustNotSetLocationIfNotCreated(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Empty(w.Header().Get("Location"))
+}
+
+func Test_WriteMustSetAllowFromLinksToSelf(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("GET, HEAD, DELETE, PUT", w.Header().Get("Allow"))
+}
+
+func Test_WriteMustSetETagIfProvided(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource(), option.ETag(`"abc"`))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(`"abc"`, w.Header().Get("ETag"))
+}
+
+func Test_WriteMustSetLinkHeaderIfRequested(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource(), option.LinkHeader())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Header().Get("Link"), `</user/1>; rel="self"`)
+}
+
+func Test_WriteMustNotSetLinkHeaderByDefault(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Empty(w.Header().Get("Link"))
+}
+
+func Test_WriteMustOmitBodyForHead(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodHead, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusOK, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.StatusOK, w.Code)
+	a.Empty(w.Body.String())
+	a.NotEqual("0", w.Header().Get("Content-Length"))
+}
+
+func Test_WriteMustSelectFieldsFromQueryIfRequested(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1?fields=Email", nil)
+	r := newWriteTestResource()
+	r.Data("Email", "ajones@aol.com")
+
+	//act
+	err := Write(w, req, http.StatusOK, r, option.SparseFields())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `{"Email":"ajones@aol.com","_links"`)
+	a.Contains(r.Values, "Username")
+}
+
+func Test_WriteMustExpandEmbeddedResourcesFromQueryIfRequested(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1?embed=manager", nil)
+	r := newWriteTestResource()
+	r.EmbedResourceOnRequest("manager", "/user/2", func(context.Context) (resource.Resource, error) {
+		manager := resource.NewResource("User")
+		manager.Data("Username", "sanderson")
+		return manager, nil
+	})
+
+	//act
+	err := Write(w, req, http.StatusOK, r, option.ExpandEmbedded())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `"_embedded":{"manager":{"Username":"sanderson"}}`)
+}
+
+func Test_WriteMustNotRemoveEmbeddedResourcesFromCaller(t *testing.T) {
+	//arrange
+	r := newWriteTestResource()
+	r.EmbedResourceOnRequest("manager", "/user/2", func(context.Context) (resource.Resource, error) {
+		return resource.NewResource("User"), nil
+	})
+	_ = Write(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/user/1", nil), http.StatusOK, r, option.ExpandEmbedded())
+	w := httptest.NewRecorder()
+
+	//act
+	err := Write(w, httptest.NewRequest(http.MethodGet, "/user/1?embed=manager", nil), http.StatusOK, r, option.ExpandEmbedded())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `"_embedded":{"manager":{}}`)
+}
+
+func Test_WriteMustResolveEmbeddedFuncs(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	r := newWriteTestResource()
+	r.EmbedFunc("manager", func(context.Context) (resource.Resource, error) {
+		manager := resource.NewResource("User")
+		manager.Data("Username", "sanderson")
+		return manager, nil
+	})
+
+	//act
+	err := Write(w, req, http.StatusOK, r, option.EmbedWorkers(2))
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Contains(w.Body.String(), `"_embedded":{"manager":{"Username":"sanderson"}}`)
+}
+
+func Test_MarshalResourceMustResolveEmbeddedFuncs(t *testing.T) {
+	//arrange
+	r := newWriteTestResource()
+	r.EmbedFunc("manager", func(context.Context) (resource.Resource, error) {
+		manager := resource.NewResource("User")
+		manager.Data("Username", "sanderson")
+		return manager, nil
+	})
+
+	//act
+	body, _ := MarshalResource(map[string][]string{}, r)
+
+	//assert
+	assert.Contains(t, body, `"_embedded":{"manager":{"Username":"sanderson"}}`)
+}
+
+func Test_MarshalResourceMustReturnEmptyBodyWhenResolverFails(t *testing.T) {
+	//arrange
+	r := newWriteTestResource()
+	r.EmbedFunc("manager", func(context.Context) (resource.Resource, error) {
+		return resource.Resource{}, errors.New("manager lookup failed")
+	})
+
+	//act
+	body, contentType := MarshalResource(map[string][]string{}, r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("", body)
+	a.Equal("application/json", contentType)
+}
+
+func Test_MarshalResourceMustReturnEmptyBodyWhenEncodingFails(t *testing.T) {
+	//arrange
+	r := newWriteTestResource()
+	manager := resource.NewResource("User")
+	manager.Data("OnChange", func() {})
+	r.EmbedResource("manager", manager)
+
+	//act
+	body, contentType := MarshalResource(map[string][]string{}, r)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("", body)
+	a.Equal("application/json", contentType)
+}
+
+func Test_WriteMustApplyAuthorizerFromContext(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	req = req.WithContext(resource.WithAuthorizer(req.Context(), resource.HasPermissions(nil), resource.DisableUnauthorizedLinks))
+	r := newWriteTestResource()
+	r.Links["deleteUser"].Permissions = []string{"user:delete"}
+
+	//act
+	err := Write(w, req, http.StatusOK, r)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("GET, HEAD, PUT", w.Header().Get("Allow"))
+	a.Contains(w.Body.String(), `"deleteUser":{"href":"/user/1","verb":"DELETE","disabled":true}`)
+}
+
+func Test_WriteMustOmitBodyForNoContent(t *testing.T) {
+	//arrange
+	var writeErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeErr = Write(w, req, http.StatusNoContent, newWriteTestResource())
+	}))
+	defer server.Close()
+
+	//act
+	response, err := http.Get(server.URL)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.NoError(writeErr)
+	a.Equal(http.StatusNoContent, response.StatusCode)
+	a.Empty(response.Header.Get("Content-Type"))
+	a.Empty(response.Header.Get("Content-Length"))
+}
+
+func Test_WriteMustOmitBodyForNotModified(t *testing.T) {
+	//arrange
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+
+	//act
+	err := Write(w, req, http.StatusNotModified, newWriteTestResource())
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.StatusNotModified, w.Code)
+	a.Empty(w.Body.String())
+}
+
+func Test_WriteMustSetContentLengthForHeadFromEncodedBody(t *testing.T) {
+	//arrange
+	getRecorder, headRecorder := httptest.NewRecorder(), httptest.NewRecorder()
+
+	//act
+	_ = Write(getRecorder, httptest.NewRequest(http.MethodGet, "/user/1", nil), http.StatusOK, newWriteTestResource())
+	_ = Write(headRecorder, httptest.NewRequest(http.MethodHead, "/user/1", nil), http.StatusOK, newWriteTestResource())
+
+	//assert
+	assert.Equal(t, strconv.Itoa(getRecorder.Body.Len()), headRecorder.Header().Get("Content-Length"))
+}
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedResourceOnRequest(name string, href string, resolver func(ctx context.Context) (Resource, error)) ConfigureLink {
+	if r.Embedded == nil {
+		r.Embedded = make(EmbeddedResources)
+	}
+	r.Embedded[name] = deferredEmbed{resolve: func(ctx context.Context) (interface{}, error) {
+		return resolver(ctx)
+	}, onRequest: true}
+
+	return r.Link(name, href)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedResourcesOnRequest(name string, href string, resolver func(ctx context.Context) ([]Resource, error)) ConfigureLink {
+	if r.Embedded == nil {
+		r.Embedded = make(EmbeddedResources)
+	}
+	r.Embedded[name] = deferredEmbed{resolve: func(ctx context.Context) (interface{}, error) {
+		return resolver(ctx)
+	}, onRequest: true}
+
+	return r.Link(name, href)
+}
+
+func EmbedsFromQuery(query url.Values) []string {
+	paths := make([]string, 0)
+	for _, value := range query["embed"] {
+		for _, path := range strings.Split(value, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) SelectEmbedded(paths []string) *Resource {
+	*r = copyEmbedded(*r, paths, true)
+	return r
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) ExpandEmbedded(ctx context.Context, paths []string) error {
+	return r.SelectEmbedded(paths).ResolveEmbedded(ctx, defaultEmbedWorkers)
+}
+
+func requestedEmbeds(paths []string) map[string][]string {
+	requested := make(map[string][]string)
+	for _, path := range paths {
+		name, child, _ := strings.Cut(path, ".")
+		if _, ok := requested[name]; !ok {
+			requested[name] = make([]string, 0)
+		}
+		if child != "" {
+			requested[name] = append(requested[name], child)
+		}
+	}
+	return requested
+}
@@ -0,0 +1,146 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+func newExpandTestUser(username string) Resource {
+	r := NewResource("User")
+	r.Data("Username", username)
+	r.EmbedResourceOnRequest("manager", "/user/1/manager", func(context.Context) (Resource, error) {
+		manager := NewResource("User")
+		manager.Data("Username", "manager of "+username)
+		return manager, nil
+	})
+	return r
+}
+
+func newExpandTestUserList() Resource {
+	r := NewResource("UserList")
+	r.EmbedResourcesOnRequest("users", "/user", func(context.Context) ([]Resource, error) {
+		return []Resource{newExpandTestUser("ajones"), newExpandTestUser("sanderson")}, nil
+	})
+	return r
+}
+
+func Test_EmbedsFromQueryMustReadEmbedParameter(t *testing.T) {
+	//arrange
+	query, _ := url.ParseQuery("embed=users,users.manager&embed=owner")
+
+	//act
+	paths := EmbedsFromQuery(query)
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]string{"users", "users.manager", "owner"}, paths)
+}
+
+func Test_EmbedOnRequestMustAddFallbackLink(t *testing.T) {
+	//arrange
+	//act
+	r := newExpandTestUserList()
+
+	//assert
+	a := assert.New(t)
+	a.Equal("/user", r.Links["users"].Href)
+}
+
+func Test_ExpandEmbeddedMustRemoveRelationsNotRequested(t *testing.T) {
+	//arrange
+	r := newExpandTestUserList()
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{})
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Empty(r.Embedded)
+	a.Equal("/user", r.Links["users"].Href)
+}
+
+func Test_ExpandEmbeddedMustResolveRequestedRelations(t *testing.T) {
+	//arrange
+	r := newExpandTestUserList()
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{"users"})
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	users := r.Embedded["users"].([]Resource)
+	a.Len(users, 2)
+	a.Equal("ajones", users[0].Values["Username"])
+	_, ok := users[0].Embedded["manager"]
+	a.False(ok, "nested relation must not be embedded unless requested")
+}
+
+func Test_ExpandEmbeddedMustResolveNestedRelations(t *testing.T) {
+	//arrange
+	r := newExpandTestUserList()
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{"users.manager"})
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	users := r.Embedded["users"].([]Resource)
+	manager := users[1].Embedded["manager"].(Resource)
+	a.Equal("manager of sanderson", manager.Values["Username"])
+}
+
+func Test_ExpandEmbeddedMustExpandWithinEagerlyEmbeddedResources(t *testing.T) {
+	//arrange
+	r := NewResource("Account")
+	r.EmbedResource("owner", newExpandTestUser("ajones"))
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{"owner.manager"})
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	manager := r.Embedded["owner"].(Resource).Embedded["manager"].(Resource)
+	a.Equal("manager of ajones", manager.Values["Username"])
+}
+
+func Test_ExpandEmbeddedMustReturnResolverErrors(t *testing.T) {
+	//arrange
+	r := NewResource("Account")
+	r.EmbedResourceOnRequest("owner", "/owner", func(context.Context) (Resource, error) {
+		return NewResource(), errors.New("owner service unavailable")
+	})
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{"owner"})
+
+	//assert
+	a := assert.New(t)
+	a.EqualError(err, "unable to embed 'owner': owner service unavailable")
+	_, ok := r.Embedded["owner"]
+	a.False(ok)
+}
+
+func Test_ExpandEmbeddedMustNotChangeOriginalResource(t *testing.T) {
+	//arrange
+	original := newExpandTestUserList()
+	r := original
+
+	//act
+	err := r.ExpandEmbedded(context.Background(), []string{})
+	again := original
+	againErr := again.ExpandEmbedded(context.Background(), []string{"users"})
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.NoError(againErr)
+	a.Contains(original.Embedded, "users")
+	a.Len(again.Embedded["users"].([]Resource), 2)
+}
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"net/url"
+	"strings"
+)
+
+type FieldSelection map[string][]string
+
+func FieldsFromQuery(query url.Values) FieldSelection {
+	selection := make(FieldSelection)
+
+	for name, values := range query {
+		relation := ""
+		if name != "fields" {
+			if !strings.HasPrefix(name, "fields[") || !strings.HasSuffix(name, "]") {
+				continue
+			}
+			relation = name[len("fields[") : len(name)-1]
+		}
+
+		for _, value := range values {
+			for _, field := range strings.Split(value, ",") {
+				if field = strings.TrimSpace(field); field != "" {
+					selection[relation] = append(selection[relation], field)
+				}
+			}
+		}
+	}
+
+	return selection
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) SelectFields(selection FieldSelection) *Resource {
+	*r = selectFields(*r, selection, "")
+	return r
+}
+
+func selectFields(r Resource, selection FieldSelection, relation string) Resource {
+	if fields, ok := selection[relation]; ok {
+		r.Values = selectMappedData(r.Values, fields)
+	}
+
+	if len(r.Embedded) == 0 {
+		return r
+	}
+
+	embedded := make(EmbeddedResources)
+	for name, value := range r.Embedded {
+		embeddedRelation := name
+		if relation != "" {
+			embeddedRelation = relation + "." + name
+		}
+
+		if embeddedResource, ok := value.(Resource); ok {
+			embedded[name] = selectFields(embeddedResource, selection, embeddedRelation)
+		} else if embeddedResourceList, ok := value.([]Resource); ok {
+			selected := make([]Resource, len(embeddedResourceList))
+			for i, embeddedResource := range embeddedResourceList {
+				selected[i] = selectFields(embeddedResource, selection, embeddedRelation)
+			}
+			embedded[name] = selected
+		} else {
+			embedded[name] = value
+		}
+	}
+	r.Embedded = embedded
+
+	return r
+}
+
+func selectMappedData(md MappedData, fields []string) MappedData {
+	children := make(map[string][]string)
+	selected := make(MappedData)
+
+	for _, field := range fields {
+		name, child, isPath := strings.Cut(field, ".")
+		if isPath {
+			children[name] = append(children[name], child)
+			continue
+		}
+
+		if value, ok := md[name]; ok {
+			selected[name] = value
+		}
+	}
+
+	for name, childFields := range children {
+		if _, ok := selected[name]; ok {
+			continue
+		}
+
+		switch value := md[name].(type) {
+		case MappedData:
+			selected[name] = selectMappedData(value, childFields)
+		case []MappedData:
+			slice := make([]MappedData, len(value))
+			for i, item := range value {
+				slice[i] = selectMappedData(item, childFields)
+			}
+			selected[name] = slice
+		}
+	}
+
+	return selected
+}
+
+func (cl ConfigureLink) FieldsParameter(fields ...string) ConfigureLink {
+	return cl.Parameter("fields", option.ListOfValues(fields))
+}
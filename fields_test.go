@@ -0,0 +1,123 @@
+package resource
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+type fieldsTestAddress struct {
+	City  string
+	State string
+}
+
+type fieldsTestUser struct {
+	Username string
+	Email    string
+	Address  fieldsTestAddress
+	Previous []fieldsTestAddress
+}
+
+func newFieldsTestUser() fieldsTestUser {
+	return fieldsTestUser{
+		"ajones",
+		"ajones@aol.com",
+		fieldsTestAddress{"Springfield", "IL"},
+		[]fieldsTestAddress{{"Chicago", "IL"}, {"Dallas", "TX"}},
+	}
+}
+
+func Test_FieldsFromQueryMustReadTopLevelAndRelationFields(t *testing.T) {
+	//arrange
+	query, _ := url.ParseQuery("fields=Username,Email&fields[users]=Username&fields[users.manager]=Email&other=1")
+
+	//act
+	selection := FieldsFromQuery(query)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(FieldSelection{
+		"":              {"Username", "Email"},
+		"users":         {"Username"},
+		"users.manager": {"Email"},
+	}, selection)
+}
+
+func Test_SelectFieldsMustPruneValues(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+	r.MapAllDataFrom(newFieldsTestUser())
+
+	//act
+	r.SelectFields(FieldSelection{"": {"Username"}})
+
+	//assert
+	a := assert.New(t)
+	a.Equal(MappedData{"Username": "ajones"}, r.Values)
+}
+
+func Test_SelectFieldsMustPruneNestedValuesByPath(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+	r.MapAllDataFrom(newFieldsTestUser())
+
+	//act
+	r.SelectFields(FieldSelection{"": {"Address.City", "Previous.State"}})
+
+	//assert
+	a := assert.New(t)
+	a.Equal(MappedData{"City": "Springfield"}, r.Values["Address"])
+	a.Equal([]MappedData{{"State": "IL"}, {"State": "TX"}}, r.Values["Previous"])
+	_, ok := r.Values["Username"]
+	a.False(ok)
+}
+
+func Test_SelectFieldsMustKeepValuesWithoutSelection(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+	r.MapAllDataFrom(newFieldsTestUser())
+
+	//act
+	r.SelectFields(FieldSelection{"users": {"Username"}})
+
+	//assert
+	a := assert.New(t)
+	a.Len(r.Values, 4)
+}
+
+func Test_SelectFieldsMustPruneEmbeddedResourcesByRelation(t *testing.T) {
+	//arrange
+	manager := NewResource("User")
+	manager.MapAllDataFrom(newFieldsTestUser())
+
+	user := NewResource("User")
+	user.MapAllDataFrom(newFieldsTestUser())
+	user.EmbedResource("manager", manager)
+
+	r := NewResource("UserList")
+	r.Data("count", 1)
+	r.EmbedResources("users", []Resource{user})
+
+	//act
+	r.SelectFields(FieldSelection{"users": {"Username"}, "users.manager": {"Email"}})
+
+	//assert
+	a := assert.New(t)
+	a.Equal(MappedData{"count": 1}, r.Values)
+	selectedUser := r.Embedded["users"].([]Resource)[0]
+	a.Equal(MappedData{"Username": "ajones"}, selectedUser.Values)
+	a.Equal(MappedData{"Email": "ajones@aol.com"}, selectedUser.Embedded["manager"].(Resource).Values)
+	a.Len(user.Values, 4, "original embedded resource must not be changed")
+}
+
+func Test_FieldsParameterMustAdvertiseFields(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+
+	//act
+	r.Link("self", "/user/1").FieldsParameter("Username", "Email")
+
+	//assert
+	a := assert.New(t)
+	a.Equal(LinkParameter{Name: "fields", ListOfValues: "Username,Email"}, r.Links["self"].Parameters[0])
+}
@@ -5,6 +5,7 @@ import (
 	resource "github.com/slyjeff/rest-resource"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -34,11 +35,7 @@ func newOpenApi(info Info, server string, resources []resource.Resource) openApi
 			doc.addPath(*link, linkName)
 		}
 
-		if r.Schema == "" {
-			continue
-		}
-
-		if _, ok := doc.Components.Schemas[r.Schema]; !ok {
+		if _, ok := doc.Components.Schemas[r.Schema]; !ok && r.Schema != "" {
 			doc.Components.Schemas[r.Schema] = newSchemaFromResource(r)
 		}
 
@@ -66,18 +63,24 @@ type openApi struct {
 }
 
 func (openApi *openApi) addPath(link resource.Link, summary string) {
-	path, ok := openApi.Paths[link.Href]
+	href, _, _ := strings.Cut(link.Href, "?")
+	path, ok := openApi.Paths[href]
 	if !ok {
 		path = make(Path)
-		parameters := getPathParameters(link.Href)
+		parameters := getPathParameters(href)
 		if len(parameters) > 0 {
 			path["parameters"] = parameters
 		}
 
-		openApi.Paths[link.Href] = path
+		openApi.Paths[href] = path
 	}
 
 	verb := strings.ToLower(link.Verb)
+	if operation, ok := path[verb].(Operation); ok {
+		path[verb] = operation.addQueryParameters(getQueryParameters(link))
+		return
+	}
+
 	if _, ok := path[verb]; !ok {
 		queryParameters := getQueryParameters(link)
 		bodySchema := ""
@@ -100,7 +103,7 @@ func getPathParameters(url string) []Parameter {
 
 	for _, parameter := range parameterNames {
 		parameter = parameter[1 : len(parameter)-1]
-		parameters = append(parameters, Parameter{parameter, "path", true, newIntSchema()})
+		parameters = append(parameters, Parameter{parameter, "path", true, "", newIntSchema()})
 	}
 
 	return parameters
@@ -114,7 +117,7 @@ func getQueryParameters(link resource.Link) []Parameter {
 	}
 
 	for _, parameter := range link.Parameters {
-		parameters = append(parameters, Parameter{parameter.Name, "query", false, newSchemaFromDataType(parameter.DataType)})
+		parameters = append(parameters, Parameter{parameter.Name, "query", parameter.Required, parameter.Description, newSchemaFromParameter(parameter)})
 	}
 
 	return parameters
@@ -159,10 +162,11 @@ type License struct {
 type Path map[string]interface{}
 
 type Parameter struct {
-	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
-	In       string `json:"in,omitempty" yaml:"in,omitempty"`
-	Required bool   `json:"required" yaml:"required"`
-	Schema   Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Name        string `json:"name,omitempty" yaml:"name,omitempty"`
+	In          string `json:"in,omitempty" yaml:"in,omitempty"`
+	Required    bool   `json:"required" yaml:"required"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema      Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
 }
 
 type Operation struct {
@@ -196,6 +200,24 @@ func newOperation(codes []int, description string, schema string, queryParameter
 	return Operation{description, responses, queryParameters, requestBody}
 }
 
+func (o Operation) addQueryParameters(parameters []Parameter) Operation {
+	for _, parameter := range parameters {
+		found := false
+		for _, existing := range o.QueryParameters {
+			if existing.Name == parameter.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			o.QueryParameters = append(o.QueryParameters, parameter)
+		}
+	}
+
+	return o
+}
+
 type DataObject struct {
 	Description string             `json:"description,omitempty" yaml:"description,omitempty"`
 	Content     map[string]Content `json:"content,omitempty" yaml:"content,omitempty"`
@@ -218,14 +240,22 @@ type Components struct {
 }
 
 type Schema struct {
-	Type       string            `json:"type,omitempty" yaml:"type,omitempty"`
-	Format     string            `json:"format,omitempty" yaml:"format,omitempty"`
-	Properties map[string]Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
-	Items      *Schema           `json:"items,omitempty" yaml:"items,omitempty"`
+	Type        string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Format      string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Properties  map[string]Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Items       *Schema           `json:"items,omitempty" yaml:"items,omitempty"`
+	Required    []string          `json:"required,omitempty" yaml:"required,omitempty"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Enum        []string          `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Minimum     *float64          `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum     *float64          `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	MinLength   *int              `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength   *int              `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Pattern     string            `json:"pattern,omitempty" yaml:"pattern,omitempty"`
 }
 
 func newSchemaFromResource(r resource.Resource) Schema {
-	schema := Schema{"object", "", make(map[string]Schema), nil}
+	schema := Schema{Type: "object", Properties: make(map[string]Schema)}
 
 	for name, value := range r.Values {
 		schema.Properties[name] = newSchemaFromValue(value)
@@ -239,10 +269,50 @@ func newSchemaFromResource(r resource.Resource) Schema {
 }
 
 func newSchemaFromParameters(parameters []resource.LinkParameter) Schema {
-	schema := Schema{"object", "", make(map[string]Schema), nil}
+	schema := Schema{Type: "object", Properties: make(map[string]Schema)}
 
 	for _, parameter := range parameters {
-		schema.Properties[parameter.Name] = newSchemaFromDataType(parameter.DataType)
+		schema.Properties[parameter.Name] = newSchemaFromParameter(parameter)
+		if parameter.Required {
+			schema.Required = append(schema.Required, parameter.Name)
+		}
+	}
+
+	return schema
+}
+
+func newSchemaFromParameter(parameter resource.LinkParameter) Schema {
+	schema := newSchemaFromDataType(parameter.DataType)
+	schema.Description = parameter.Description
+	schema.Pattern = parameter.Pattern
+
+	if parameter.Format != "" {
+		schema.Format = parameter.Format
+	}
+
+	if parameter.ListOfValues != "" {
+		schema.Enum = strings.Split(parameter.ListOfValues, ",")
+	}
+
+	if min, err := strconv.ParseFloat(parameter.Min, 64); err == nil {
+		schema.Minimum = &min
+	}
+
+	if max, err := strconv.ParseFloat(parameter.Max, 64); err == nil {
+		schema.Maximum = &max
+	}
+
+	if parameter.MinLength > 0 {
+		schema.MinLength = &parameter.MinLength
+	}
+
+	if parameter.MaxLength > 0 {
+		schema.MaxLength = &parameter.MaxLength
+	}
+
+	if parameter.Multiple {
+		items := schema
+		return Schema{Type: "array", Properties: make(map[string]Schema), Items: &items}
 	}
 
 	return schema
@@ -266,27 +336,27 @@ func newSchemaFromValue(value interface{}) Schema {
 }
 
 func newInt32Schema() Schema {
-	return Schema{"integer", "int32", make(map[string]Schema), nil}
+	return Schema{Type: "integer", Format: "int32", Properties: make(map[string]Schema)}
 }
 
 func newIntSchema() Schema {
-	return Schema{"integer", "int64", make(map[string]Schema), nil}
+	return Schema{Type: "integer", Format: "int64", Properties: make(map[string]Schema)}
 }
 
 func newFloatSchema() Schema {
-	return Schema{"number", "float", make(map[string]Schema), nil}
+	return Schema{Type: "number", Format: "float", Properties: make(map[string]Schema)}
 }
 
 func newBoolSchema() Schema {
-	return Schema{"boolean", "", make(map[string]Schema), nil}
+	return Schema{Type: "boolean", Properties: make(map[string]Schema)}
 }
 
 func newStringSchema() Schema {
-	return Schema{"string", "", make(map[string]Schema), nil}
+	return Schema{Type: "string", Properties: make(map[string]Schema)}
 }
 
 func newSchemaFromEmbedded(embeddedResources resource.EmbeddedResources) Schema {
-	schema := Schema{"object", "", make(map[string]Schema), nil}
+	schema := Schema{Type: "object", Properties: make(map[string]Schema)}
 
 	for name, embedded := range embeddedResources {
 		if embeddedResource, ok := embedded.(resource.Resource); ok {
@@ -300,7 +370,7 @@ func newSchemaFromEmbedded(embeddedResources resource.EmbeddedResources) Schema
 }
 
 func newSchemaFromEmbeddedList(resources []resource.Resource) Schema {
-	schema := Schema{"array", "", make(map[string]Schema), nil}
+	schema := Schema{Type: "array", Properties: make(map[string]Schema)}
 	if len(resources) == 0 {
 		return schema
 	}
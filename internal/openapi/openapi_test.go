@@ -0,0 +1,79 @@
+package openapi
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newConstrainedLink() resource.Link {
+	r := resource.NewResource()
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.Required(), option.Description("Login name"), option.MinLength(3), option.MaxLength(20), option.Pattern(`\w+`)).
+		Parameter("age", option.DataType("int"), option.Min(18), option.Max(120)).
+		Parameter("role", option.ListOfValues([]string{"admin", "user"})).
+		Parameter("tags", option.Multiple(), option.MaxLength(10)).
+		Parameter("email", option.ParameterFormat("email"))
+	return *r.Links["createUser"]
+}
+
+func Test_NewSchemaFromParametersMustMapConstraints(t *testing.T) {
+	//arrange
+	link := newConstrainedLink()
+
+	//act
+	schema := newSchemaFromParameters(link.Parameters)
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]string{"username"}, schema.Required)
+
+	username := schema.Properties["username"]
+	a.Equal("string", username.Type)
+	a.Equal("Login name", username.Description)
+	a.Equal(3, *username.MinLength)
+	a.Equal(20, *username.MaxLength)
+	a.Equal(`\w+`, username.Pattern)
+
+	age := schema.Properties["age"]
+	a.Equal("integer", age.Type)
+	a.Equal(float64(18), *age.Minimum)
+	a.Equal(float64(120), *age.Maximum)
+
+	a.Equal([]string{"admin", "user"}, schema.Properties["role"].Enum)
+	a.Equal("email", schema.Properties["email"].Format)
+}
+
+func Test_NewSchemaFromParametersMustUseArrayForMultiple(t *testing.T) {
+	//arrange
+	link := newConstrainedLink()
+
+	//act
+	schema := newSchemaFromParameters(link.Parameters)
+
+	//assert
+	a := assert.New(t)
+	tags := schema.Properties["tags"]
+	a.Equal("array", tags.Type)
+	a.Equal("string", tags.Items.Type)
+	a.Equal(10, *tags.Items.MaxLength)
+}
+
+func Test_GetQueryParametersMustMapRequiredAndConstraints(t *testing.T) {
+	//arrange
+	r := resource.NewResource()
+	r.Link("searchUsers", "/user").
+		Parameter("username", option.Required()).
+		Parameter("page", option.DataType("int"), option.Min(1))
+
+	//act
+	parameters := getQueryParameters(*r.Links["searchUsers"])
+
+	//assert
+	a := assert.New(t)
+	a.Len(parameters, 2)
+	a.True(parameters[0].Required)
+	a.False(parameters[1].Required)
+	a.Equal(float64(1), *parameters[1].Schema.Minimum)
+}
@@ -18,7 +18,7 @@ func getDocumentation(c echo.Context) error {
 
 	resources := []resource.Resource{
 		newApplicationResource(),
-		newUserListResource(defaultUserList, ""),
+		newUserListResource(defaultUserList, userSearch{}),
 		newUserResource(defaultUser),
 	}
 
@@ -2,19 +2,16 @@ package main
 
 import (
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/slyjeff/rest-resource"
-	"github.com/slyjeff/rest-resource/encoding"
+	echoresource "github.com/slyjeff/rest-resource/echo"
 	"github.com/slyjeff/rest-resource/option"
 	"net/http"
 )
 
 func main() {
-	e := echo.New()
+	registerRoutes()
 
-	e.Pre(middleware.MethodOverrideWithConfig(middleware.MethodOverrideConfig{
-		Getter: middleware.MethodFromForm("_method"),
-	}))
+	e := echoresource.Configure(echo.New())
 
 	e.GET("/doc", getDocumentation)
 
@@ -45,8 +42,5 @@ func newApplicationResource() resource.Resource {
 }
 
 func respond(c echo.Context, statusCode int, r resource.Resource) error {
-	value, contentType := encoding.MarshalResource(c.Request().Header, r)
-
-	c.Response().Header().Set("Content-Type", contentType)
-	return c.String(statusCode, value)
+	return echoresource.Respond(c, statusCode, r)
 }
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+)
+
+func registerRoutes() {
+	routes := resource.DefaultRouteRegistry
+
+	routes.Route("getUser", "/user/{Id}").
+		Schema("User")
+
+	routes.Route("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.Required(), option.MinLength(3)).
+		Parameter("email", option.Required(), option.ParameterFormat("email")).
+		Schema("User")
+
+	routes.Route("updateUser", "/user/{Id}", option.Verb("PUT")).
+		Schema("User")
+
+	routes.Route("deleteUser", "/user/{Id}", option.Verb("DELETE"))
+}
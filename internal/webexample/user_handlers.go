@@ -4,7 +4,8 @@ import (
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/slyjeff/rest-resource"
-	"github.com/slyjeff/rest-resource/option"
+	echoresource "github.com/slyjeff/rest-resource/echo"
+	"github.com/slyjeff/rest-resource/encoding"
 	"net/http"
 	"strconv"
 )
@@ -12,84 +13,124 @@ import (
 func registerUserHandlers(e *echo.Echo) {
 	userRepo := newUserRepo()
 
-	e.GET("/user", func(c echo.Context) error {
+	searchUsers := func(c echo.Context) error {
 		userSearch := userSearch{}
 		if err := c.Bind(&userSearch); err != nil {
-			return c.String(http.StatusInternalServerError, "")
+			return err
 		}
 
 		users := userRepo.Search(userSearch)
 
-		r := newUserListResource(users, userSearch.Criteria())
+		r := newUserListResource(users, userSearch)
 
 		return respond(c, http.StatusOK, r)
-	})
+	}
 
-	e.GET("/user/:Id", func(c echo.Context) error {
+	getUser := func(c echo.Context) error {
 		id, err := strconv.Atoi(c.Param("Id"))
 		if err != nil {
-			return c.String(http.StatusBadRequest, "Invalid Id")
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Id")
 		}
 
 		u, ok := userRepo.GetById(id)
 		if !ok {
-			return c.String(http.StatusNotFound, "User not found.")
+			return userNotFound(c, id)
 		}
 
 		r := newUserResource(*u)
 		return respond(c, http.StatusOK, r)
-	})
+	}
+
+	createUser := func(c echo.Context) error {
+		route, _ := resource.DefaultRouteRegistry.Find("createUser")
+		if _, err := encoding.Validate(route, c.Request()); err != nil {
+			return err
+		}
 
-	e.POST("/user", func(c echo.Context) error {
 		user := user{}
 		if err := c.Bind(&user); err != nil {
-			return c.String(http.StatusInternalServerError, "")
+			return err
 		}
 		userRepo.Add(&user)
 
 		r := newUserResource(user)
 
 		return respond(c, http.StatusCreated, r)
-	})
+	}
 
-	e.PUT("/user/:Id", func(c echo.Context) error {
+	updateUser := func(c echo.Context) error {
 		id, err := strconv.Atoi(c.Param("Id"))
 		if err != nil {
-			return c.String(http.StatusBadRequest, "Invalid Id")
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Id")
 		}
 
 		u, ok := userRepo.GetById(id)
 		if !ok {
-			return c.String(http.StatusNotFound, "User not found.")
+			return userNotFound(c, id)
 		}
 
 		if err := c.Bind(u); err != nil {
-			return c.String(http.StatusInternalServerError, "")
+			return err
 		}
 
 		r := newUserResource(*u)
 
 		return respond(c, http.StatusOK, r)
-	})
+	}
 
-	e.DELETE("/user/:Id", func(c echo.Context) error {
+	deleteUser := func(c echo.Context) error {
 		id, err := strconv.Atoi(c.Param("Id"))
 		if err != nil {
-			return c.String(http.StatusBadRequest, "Invalid Id")
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Id")
 		}
 
 		ok := userRepo.Delete(id)
 		if !ok {
-			return c.String(http.StatusNotFound, "User not found.")
+			return userNotFound(c, id)
 		}
 
 		return c.String(http.StatusOK, "User deleted.")
+	}
+
+	echoresource.RouteLinks(e, newUserListResource(nil, userSearch{}), map[string]echo.HandlerFunc{
+		"self":       searchUsers,
+		"createUser": createUser,
+	})
+
+	echoresource.RouteLinks(e, newUserResource(user{}), map[string]echo.HandlerFunc{
+		"self":       getUser,
+		"updateUser": updateUser,
+		"deleteUser": deleteUser,
 	})
 }
 
+func userNotFound(c echo.Context, id int) error {
+	problem := encoding.NewProblem(http.StatusNotFound).
+		Detail(fmt.Sprintf("User %d not found.", id)).
+		Instance(c.Request().URL.Path).
+		Link("users", "/user")
+
+	return respond(c, http.StatusNotFound, problem.Resource())
+}
+
 type userSearch struct {
 	Username string `query:"username"`
 	IsActive string `query:"is_active"`
+	Page     int    `query:"page"`
+	Size     int    `query:"size"`
+}
+
+const defaultPageSize = 10
+
+func (s userSearch) PageNumber() int {
+	return max(s.Page, 1)
+}
+
+func (s userSearch) PageSize() int {
+	if s.Size < 1 {
+		return defaultPageSize
+	}
+	return s.Size
 }
 
 func (s userSearch) Criteria() string {
@@ -114,34 +155,38 @@ func addQueryParameter(s, name string, value string) string {
 	return s
 }
 
-func newUserListResource(users []user, queryParams string) resource.Resource {
+func newUserListResource(users []user, search userSearch) resource.Resource {
 	r := resource.NewResource("UserList")
-	r.Uri("/user" + queryParams)
 
-	userResources := make([]resource.Resource, len(users))
-	for i, user := range users {
-		userResources[i] = newUserResource(user)
+	pageNumber, pageSize := search.PageNumber(), search.PageSize()
+	start := min((pageNumber-1)*pageSize, len(users))
+	end := min(start+pageSize, len(users))
+
+	userResources := make([]resource.Resource, 0, end-start)
+	for _, user := range users[start:end] {
+		userResources = append(userResources, newUserResource(user))
+	}
+
+	criteria := search.Criteria()
+	if criteria == "" {
+		criteria = "?"
+	} else {
+		criteria += "&"
 	}
 
-	r.EmbedResources("users", userResources)
-	r.Link("createUser", "/user", option.Verb("POST")).
-		Parameter("userName").
-		Parameter("Email").
-		Schema("User")
+	r.EmbedPage("users", userResources, pageNumber, pageSize, len(users), "/user"+criteria+"page={page}&size={size}")
+	r.LinkTo("createUser")
 
 	return r
 }
 
 func newUserResource(user user) resource.Resource {
-	url := resource.ConstructUriFromTemplate("/user/{Id}", user.Id)
 	r := resource.NewResource("User")
-	r.Uri(url)
+	r.UriTo("getUser", user.Id)
 	r.MapAllDataFrom(user)
-	r.Link("updateUser", url, option.Verb("PUT")).
-		Parameter("username", option.Default(user.Username)).
-		Parameter("email", option.Default(user.Email)).
-		Schema("User")
-	r.Link("deleteUser", url, option.Verb("DELETE"))
+	r.LinkTo("updateUser", user.Id).
+		ParametersFrom(user, "Id")
+	r.LinkTo("deleteUser", user.Id)
 
 	return r
 }
@@ -29,10 +29,26 @@ func (r *Resource) Link(name string, href string, linkOptions ...option.Option)
 		case "DELETE":
 			link.ResponseCodes = []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError}
 		}
+
+		if option.FindOptimisticConcurrencyOption(linkOptions) && verb != "GET" && verb != "POST" {
+			link.ResponseCodes = append(link.ResponseCodes, http.StatusPreconditionFailed)
+		}
 	}
 
 	link.IsTemplated = option.FindTemplatedOption(linkOptions)
 
+	if title, ok := option.FindTitleOption(linkOptions); ok {
+		link.Title = title
+	}
+
+	if mediaType, ok := option.FindMediaTypeOption(linkOptions); ok {
+		link.Type = mediaType
+	}
+
+	if permissions, ok := option.FindRequiresOption(linkOptions); ok {
+		link.Permissions = permissions
+	}
+
 	r.addLink(name, link)
 
 	return ConfigureLink{r, r.Links[name]}
@@ -58,6 +74,38 @@ func (cl ConfigureLink) Parameter(name string, parameterOptions ...option.Option
 		parameter.DataType = dataType
 	}
 
+	parameter.Required = option.FindRequiredOption(parameterOptions)
+
+	if description, ok := option.FindDescriptionOption(parameterOptions); ok {
+		parameter.Description = description
+	}
+
+	if min, ok := option.FindMinOption(parameterOptions); ok {
+		parameter.Min = min
+	}
+
+	if max, ok := option.FindMaxOption(parameterOptions); ok {
+		parameter.Max = max
+	}
+
+	if minLength, ok := option.FindMinLengthOption(parameterOptions); ok {
+		parameter.MinLength = minLength
+	}
+
+	if maxLength, ok := option.FindMaxLengthOption(parameterOptions); ok {
+		parameter.MaxLength = maxLength
+	}
+
+	if pattern, ok := option.FindPatternOption(parameterOptions); ok {
+		parameter.Pattern = pattern
+	}
+
+	parameter.Multiple = option.FindMultipleOption(parameterOptions)
+
+	if format, ok := option.FindParameterFormatOption(parameterOptions); ok {
+		parameter.Format = format
+	}
+
 	cl.link.Parameters = append(cl.link.Parameters, parameter)
 
 	return cl
@@ -68,6 +116,11 @@ func (cl ConfigureLink) Schema(schema string) ConfigureLink {
 	return cl
 }
 
+func (cl ConfigureLink) Requires(permissions ...string) ConfigureLink {
+	cl.link.Permissions = append(cl.link.Permissions, permissions...)
+	return cl
+}
+
 func (cl ConfigureLink) ResponseCodes(statuses ...int) ConfigureLink {
 	cl.link.ResponseCodes = statuses
 	return cl
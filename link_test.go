@@ -123,6 +123,45 @@ func Test_LinkMustAddParametersWithListOfValues(t *testing.T) {
 	a.Equal("1,2,3", link.Parameters[0].ListOfValues)
 }
 
+func Test_LinkMustAddParameterConstraints(t *testing.T) {
+	//arrange
+	r := NewResource()
+
+	//act
+	r.Link("createUser", "/user", option.Verb("POST")).
+		Parameter("username", option.Required(), option.Description("Login name"), option.MinLength(3), option.MaxLength(20), option.Pattern("[a-z]+")).
+		Parameter("age", option.Min(18), option.Max(120.5)).
+		Parameter("roles", option.Multiple()).
+		Parameter("email", option.ParameterFormat("email"))
+
+	//assert
+	a := assert.New(t)
+	parameters := r.Links["createUser"].Parameters
+	a.True(parameters[0].Required)
+	a.Equal("Login name", parameters[0].Description)
+	a.Equal(3, parameters[0].MinLength)
+	a.Equal(20, parameters[0].MaxLength)
+	a.Equal("[a-z]+", parameters[0].Pattern)
+	a.Equal("18", parameters[1].Min)
+	a.Equal("120.5", parameters[1].Max)
+	a.False(parameters[1].Required)
+	a.True(parameters[2].Multiple)
+	a.Equal("email", parameters[3].Format)
+}
+
+func Test_LinkParameterMustIgnoreMapFormat(t *testing.T) {
+	//arrange
+	r := NewResource()
+
+	//act
+	r.Link("updateItem", "/item/1", option.Verb("PUT")).
+		Parameter("price", option.DataType("float"), option.Format("%.2f"))
+
+	//assert
+	a := assert.New(t)
+	a.Equal("", r.Links["updateItem"].Parameters[0].Format)
+}
+
 func Test_LinkMustAddDataType(t *testing.T) {
 	//arrange
 	r := NewResource()
@@ -250,3 +289,19 @@ func Test_SettingResponseCodesMustOverrideDefaults(t *testing.T) {
 	a.Equal(http.StatusCreated, link.ResponseCodes[0])
 	a.Equal(http.StatusNotFound, link.ResponseCodes[1])
 }
+
+func Test_LinkMustAddPreconditionFailedWithOptimisticConcurrency(t *testing.T) {
+	//arrange
+	var resource Resource
+
+	//act
+	resource.Link("updateUser", "/user/1", option.Verb("PUT"), option.OptimisticConcurrency())
+	resource.Link("deleteUser", "/user/1", option.Verb("DELETE"), option.OptimisticConcurrency())
+	resource.Link("createUser", "/user", option.Verb("POST"), option.OptimisticConcurrency())
+
+	//assert
+	a := assert.New(t)
+	a.Contains(resource.Links["updateUser"].ResponseCodes, http.StatusPreconditionFailed)
+	a.Contains(resource.Links["deleteUser"].ResponseCodes, http.StatusPreconditionFailed)
+	a.NotContains(resource.Links["createUser"].ResponseCodes, http.StatusPreconditionFailed)
+}
@@ -55,6 +55,10 @@ func (r *Resource) MapDataFrom(source interface{}) *ConfigureMap {
 }
 
 func (cm *ConfigureMap) Map(fieldName string, mapOptions ...option.Option) *ConfigureMap {
+	return cm.mapWhere(fieldName, func(interface{}) bool { return true }, mapOptions)
+}
+
+func (cm *ConfigureMap) mapWhere(fieldName string, predicate func(source interface{}) bool, mapOptions []option.Option) *ConfigureMap {
 	name := fieldName
 	if newName, ok := option.FindNameOption(mapOptions); ok {
 		name = newName
@@ -63,7 +67,7 @@ func (cm *ConfigureMap) Map(fieldName string, mapOptions ...option.Option) *Conf
 	for _, copyPair := range cm.copyPairs {
 		for i, v := range copyPair.sourceItems {
 			resourceData := *copyPair.destinationItems[i]
-			if _, ok := resourceData[fieldName]; ok {
+			if _, ok := resourceData[fieldName]; ok || !predicate(v) {
 				continue
 			}
 
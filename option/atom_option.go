@@ -0,0 +1,25 @@
+package option
+
+func AtomTitle(fieldName string) Option {
+	return Option{"atomTitle", fieldName}
+}
+
+func AtomUpdated(fieldName string) Option {
+	return Option{"atomUpdated", fieldName}
+}
+
+func FindAtomTitleOption(options []Option) (string, bool) {
+	return findOption(options, "atomTitle")
+}
+
+func FindAtomUpdatedOption(options []Option) (string, bool) {
+	return findOption(options, "atomUpdated")
+}
+
+func AtomBaseUri(uri string) Option {
+	return Option{"atomBaseUri", uri}
+}
+
+func FindAtomBaseUriOption(options []Option) (string, bool) {
+	return findOption(options, "atomBaseUri")
+}
@@ -1,5 +1,7 @@
 package option
 
+import "strings"
+
 func Verb(name string) Option {
 	return Option{"verb", name}
 }
@@ -8,6 +10,22 @@ func Templated() Option {
 	return Option{"isTemplated", "true"}
 }
 
+func Title(title string) Option {
+	return Option{"title", title}
+}
+
+func MediaType(mediaType string) Option {
+	return Option{"mediaType", mediaType}
+}
+
+func Requires(permissions ...string) Option {
+	return Option{"requires", strings.Join(permissions, ",")}
+}
+
+func OptimisticConcurrency() Option {
+	return Option{"optimisticConcurrency", "true"}
+}
+
 func FindVerbOption(options []Option) (string, bool) {
 	return findOption(options, "verb")
 }
@@ -16,3 +34,24 @@ func FindTemplatedOption(options []Option) bool {
 	_, isTemplated := findOption(options, "isTemplated")
 	return isTemplated
 }
+
+func FindTitleOption(options []Option) (string, bool) {
+	return findOption(options, "title")
+}
+
+func FindMediaTypeOption(options []Option) (string, bool) {
+	return findOption(options, "mediaType")
+}
+
+func FindOptimisticConcurrencyOption(options []Option) bool {
+	_, optimisticConcurrency := findOption(options, "optimisticConcurrency")
+	return optimisticConcurrency
+}
+
+func FindRequiresOption(options []Option) ([]string, bool) {
+	permissions, ok := findOption(options, "requires")
+	if !ok || permissions == "" {
+		return make([]string, 0), false
+	}
+	return strings.Split(permissions, ","), true
+}
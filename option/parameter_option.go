@@ -2,6 +2,7 @@ package option
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -33,3 +34,91 @@ func DataType(dataType string) Option {
 func FindDataType(options []Option) (string, bool) {
 	return findOption(options, "dataType")
 }
+
+func Required() Option {
+	return Option{"required", "true"}
+}
+
+func FindRequiredOption(options []Option) bool {
+	_, ok := findOption(options, "required")
+	return ok
+}
+
+func Description(description string) Option {
+	return Option{"description", description}
+}
+
+func FindDescriptionOption(options []Option) (string, bool) {
+	return findOption(options, "description")
+}
+
+func Min(value interface{}) Option {
+	return Option{"min", fmt.Sprintf("%v", value)}
+}
+
+func FindMinOption(options []Option) (string, bool) {
+	return findOption(options, "min")
+}
+
+func Max(value interface{}) Option {
+	return Option{"max", fmt.Sprintf("%v", value)}
+}
+
+func FindMaxOption(options []Option) (string, bool) {
+	return findOption(options, "max")
+}
+
+func MinLength(length int) Option {
+	return Option{"minLength", strconv.Itoa(length)}
+}
+
+func FindMinLengthOption(options []Option) (int, bool) {
+	return findIntOption(options, "minLength")
+}
+
+func MaxLength(length int) Option {
+	return Option{"maxLength", strconv.Itoa(length)}
+}
+
+func FindMaxLengthOption(options []Option) (int, bool) {
+	return findIntOption(options, "maxLength")
+}
+
+func Pattern(pattern string) Option {
+	return Option{"pattern", pattern}
+}
+
+func FindPatternOption(options []Option) (string, bool) {
+	return findOption(options, "pattern")
+}
+
+func Multiple() Option {
+	return Option{"multiple", "true"}
+}
+
+func FindMultipleOption(options []Option) bool {
+	_, ok := findOption(options, "multiple")
+	return ok
+}
+
+// ParameterFormat sets the value format of a parameter, such as "email" or "uuid"; Format is a printf format for mapped values
+func ParameterFormat(format string) Option {
+	return Option{"parameterFormat", format}
+}
+
+func FindParameterFormatOption(options []Option) (string, bool) {
+	return findOption(options, "parameterFormat")
+}
+
+func findIntOption(options []Option, option string) (int, bool) {
+	value, ok := findOption(options, option)
+	if !ok {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
@@ -0,0 +1,60 @@
+package option
+
+import "strconv"
+
+func ETag(etag string) Option {
+	return Option{"etag", etag}
+}
+
+func GenerateETag() Option {
+	return Option{"generateETag", "strong"}
+}
+
+func GenerateWeakETag() Option {
+	return Option{"generateETag", "weak"}
+}
+
+func SparseFields() Option {
+	return Option{"sparseFields", "true"}
+}
+
+func ExpandEmbedded() Option {
+	return Option{"expandEmbedded", "true"}
+}
+
+func EmbedWorkers(workers int) Option {
+	return Option{"embedWorkers", strconv.Itoa(workers)}
+}
+
+func LinkHeader() Option {
+	return Option{"linkHeader", "true"}
+}
+
+func FindETagOption(options []Option) (string, bool) {
+	return findOption(options, "etag")
+}
+
+func FindGenerateETagOption(options []Option) (string, bool) {
+	return findOption(options, "generateETag")
+}
+
+func FindLinkHeaderOption(options []Option) bool {
+	_, linkHeader := findOption(options, "linkHeader")
+	return linkHeader
+}
+
+func FindSparseFieldsOption(options []Option) bool {
+	_, sparseFields := findOption(options, "sparseFields")
+	return sparseFields
+}
+
+func FindExpandEmbeddedOption(options []Option) bool {
+	_, expandEmbedded := findOption(options, "expandEmbedded")
+	return expandEmbedded
+}
+
+func FindEmbedWorkersOption(options []Option) int {
+	workers, _ := findOption(options, "embedWorkers")
+	value, _ := strconv.Atoi(workers)
+	return value
+}
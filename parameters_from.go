@@ -0,0 +1,141 @@
+package resource
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Enumeration interface {
+	EnumValues() []string
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+const dateTimeLocalLayout = "2006-01-02T15:04:05"
+
+func (cl ConfigureLink) ParametersFrom(value interface{}, excludedFields ...string) ConfigureLink {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v = reflect.Zero(v.Type().Elem())
+			break
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return cl
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := findParameterName(field)
+		if !ok || slices.Contains(excludedFields, field.Name) || slices.Contains(excludedFields, name) {
+			continue
+		}
+
+		cl.link.Parameters = append(cl.link.Parameters, newLinkParameterFromField(name, v.Field(i)))
+	}
+
+	return cl
+}
+
+func findParameterName(field reflect.StructField) (string, bool) {
+	for _, tag := range []string{"form", "query", "json"} {
+		value, ok := field.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(value, ",")
+		if name == "-" {
+			return "", false
+		}
+
+		if name != "" {
+			return name, true
+		}
+	}
+
+	return field.Name, true
+}
+
+func newLinkParameterFromField(name string, v reflect.Value) LinkParameter {
+	parameter := newLinkParameter(name)
+
+	t := v.Type()
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		parameter.Multiple = true
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	parameter.DataType, parameter.Format = findDataType(t)
+
+	if enumeration, ok := reflect.New(t).Interface().(Enumeration); ok {
+		parameter.ListOfValues = strings.Join(enumeration.EnumValues(), ",")
+	}
+
+	parameter.DefaultValue = formatDefaultValue(v)
+
+	return parameter
+}
+
+func findDataType(t reflect.Type) (string, string) {
+	if t == timeType {
+		return "", "date-time"
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int", ""
+	case reflect.Float32, reflect.Float64:
+		return "float", ""
+	case reflect.Bool:
+		return "bool", ""
+	default:
+		return "", ""
+	}
+}
+
+func formatDefaultValue(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch value := v.Interface().(type) {
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		// datetime-local inputs reject offsets, so defaults are rendered in UTC without one
+		return value.UTC().Format(dateTimeLocalLayout)
+	case []byte:
+		return string(value)
+	}
+
+	if v.Kind() == reflect.Slice {
+		values := make([]string, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values[i] = fmt.Sprintf("%v", v.Index(i).Interface())
+		}
+		return strings.Join(values, ",")
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
@@ -0,0 +1,89 @@
+package resource
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type testRole string
+
+func (testRole) EnumValues() []string {
+	return []string{"admin", "user"}
+}
+
+type testParametersUser struct {
+	Id        int
+	Username  string    `form:"username"`
+	Email     string    `json:"email,omitempty"`
+	IsActive  bool      `query:"is_active"`
+	Rating    float64   `json:"rating"`
+	Role      testRole  `json:"role"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"createdAt"`
+	Password  string    `json:"-"`
+	secret    string
+}
+
+func Test_ParametersFromMustDeriveParametersFromStruct(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	u := testParametersUser{5, "ajones", "ajones@aol.com", false, 4.5, "admin", []string{"a", "b"}, created, "secret", "hidden"}
+
+	//act
+	r.Link("updateUser", "/user/5").
+		ParametersFrom(u, "Id")
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]LinkParameter{
+		{Name: "username", DefaultValue: "ajones"},
+		{Name: "email", DefaultValue: "ajones@aol.com"},
+		{Name: "is_active", DefaultValue: "false", DataType: "bool"},
+		{Name: "rating", DefaultValue: "4.5", DataType: "float"},
+		{Name: "role", DefaultValue: "admin", ListOfValues: "admin,user"},
+		{Name: "tags", DefaultValue: "a,b", Multiple: true},
+		{Name: "createdAt", DefaultValue: "2024-01-02T03:04:05", Format: "date-time"},
+	}, r.Links["updateUser"].Parameters)
+}
+
+func Test_ParametersFromMustSkipExcludedParameterNames(t *testing.T) {
+	//arrange
+	r := NewResource("User")
+
+	//act
+	r.Link("createUser", "/user").
+		ParametersFrom(&testParametersUser{}, "Id", "email", "rating", "role", "tags", "createdAt")
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]LinkParameter{
+		{Name: "username"},
+		{Name: "is_active", DefaultValue: "false", DataType: "bool"},
+	}, r.Links["createUser"].Parameters)
+}
+
+func Test_ParametersFromMustKeepZeroNumbersAsDefaults(t *testing.T) {
+	//arrange
+	r := NewResource("Item")
+	type item struct {
+		Quantity  int       `json:"quantity"`
+		Price     float64   `json:"price"`
+		Discount  *float64  `json:"discount"`
+		Available time.Time `json:"available"`
+	}
+
+	//act
+	r.Link("updateItem", "/item/1").
+		ParametersFrom(item{})
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]LinkParameter{
+		{Name: "quantity", DefaultValue: "0", DataType: "int"},
+		{Name: "price", DefaultValue: "0", DataType: "float"},
+		{Name: "discount", DataType: "float"},
+		{Name: "available", Format: "date-time"},
+	}, r.Links["updateItem"].Parameters)
+}
@@ -0,0 +1,276 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const defaultEmbedWorkers = 8
+
+var ErrNotLoaded = errors.New("resource not returned by loader")
+
+type deferredEmbed struct {
+	resolve   func(ctx context.Context) (interface{}, error)
+	onRequest bool
+	selected  bool
+	paths     []string
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedFunc(name string, resolver func(ctx context.Context) (Resource, error)) *Resource {
+	if r.Embedded == nil {
+		r.Embedded = make(EmbeddedResources)
+	}
+	r.Embedded[name] = deferredEmbed{resolve: func(ctx context.Context) (interface{}, error) {
+		return resolver(ctx)
+	}}
+
+	return r
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) EmbedResourcesFunc(name string, resolver func(ctx context.Context) ([]Resource, error)) *Resource {
+	if r.Embedded == nil {
+		r.Embedded = make(EmbeddedResources)
+	}
+	r.Embedded[name] = deferredEmbed{resolve: func(ctx context.Context) (interface{}, error) {
+		return resolver(ctx)
+	}}
+
+	return r
+}
+
+type EmbedError struct {
+	Relation string
+	Err      error
+}
+
+func (e EmbedError) Error() string {
+	return "unable to embed '" + e.Relation + "': " + e.Err.Error()
+}
+
+func (e EmbedError) Unwrap() error {
+	return e.Err
+}
+
+type EmbedErrors []EmbedError
+
+func (e EmbedErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, embedError := range e {
+		messages[i] = embedError.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+type pendingEmbed struct {
+	relation string
+	embedded EmbeddedResources
+	name     string
+	deferred deferredEmbed
+}
+
+type resolvedEmbed struct {
+	pending pendingEmbed
+	value   interface{}
+	err     error
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) ResolveEmbedded(ctx context.Context, workers int) error {
+	if workers < 1 {
+		workers = defaultEmbedWorkers
+	}
+
+	*r = copyEmbedded(*r, nil, false)
+
+	errs := make(EmbedErrors, 0)
+	pending := findPendingEmbeds(*r, "")
+
+	for len(pending) > 0 {
+		next := make([]pendingEmbed, 0)
+
+		for _, resolved := range resolvePendingEmbeds(ctx, pending, workers) {
+			p := resolved.pending
+			if resolved.err != nil {
+				delete(p.embedded, p.name)
+				errs = append(errs, EmbedError{p.relation, resolved.err})
+				continue
+			}
+
+			value := copyEmbeddedValue(resolved.value, p.deferred.paths, p.deferred.selected)
+			p.embedded[p.name] = value
+			next = append(next, findPendingEmbedsInValue(value, p.relation)...)
+		}
+
+		pending = next
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func resolvePendingEmbeds(ctx context.Context, pending []pendingEmbed, workers int) []resolvedEmbed {
+	results := make([]resolvedEmbed, len(pending))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(pending)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = resolvedEmbed{pending: pending[i]}
+				if err := ctx.Err(); err != nil {
+					results[i].err = err
+					continue
+				}
+				results[i].value, results[i].err = pending[i].deferred.resolve(ctx)
+			}
+		}()
+	}
+
+	for i := range pending {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func findPendingEmbeds(r Resource, relation string) []pendingEmbed {
+	pending := make([]pendingEmbed, 0)
+
+	for name, value := range r.Embedded {
+		embeddedRelation := name
+		if relation != "" {
+			embeddedRelation = relation + "." + name
+		}
+
+		if deferred, ok := value.(deferredEmbed); ok {
+			if !deferred.onRequest || deferred.selected {
+				pending = append(pending, pendingEmbed{embeddedRelation, r.Embedded, name, deferred})
+			}
+			continue
+		}
+
+		pending = append(pending, findPendingEmbedsInValue(value, embeddedRelation)...)
+	}
+
+	return pending
+}
+
+func findPendingEmbedsInValue(value interface{}, relation string) []pendingEmbed {
+	if embeddedResource, ok := value.(Resource); ok {
+		return findPendingEmbeds(embeddedResource, relation)
+	}
+
+	pending := make([]pendingEmbed, 0)
+	if embeddedResourceList, ok := value.([]Resource); ok {
+		for _, embeddedResource := range embeddedResourceList {
+			pending = append(pending, findPendingEmbeds(embeddedResource, relation)...)
+		}
+	}
+
+	return pending
+}
+
+func copyEmbedded(r Resource, paths []string, selecting bool) Resource {
+	if len(r.Embedded) == 0 {
+		return r
+	}
+
+	requested := requestedEmbeds(paths)
+	embedded := make(EmbeddedResources, len(r.Embedded))
+	for name, value := range r.Embedded {
+		childPaths, isRequested := requested[name]
+
+		if deferred, ok := value.(deferredEmbed); ok {
+			if selecting {
+				if deferred.onRequest && !isRequested {
+					continue
+				}
+				deferred.selected, deferred.paths = true, childPaths
+			}
+			embedded[name] = deferred
+			continue
+		}
+
+		embedded[name] = copyEmbeddedValue(value, childPaths, selecting)
+	}
+	r.Embedded = embedded
+
+	return r
+}
+
+func copyEmbeddedValue(value interface{}, paths []string, selecting bool) interface{} {
+	if embeddedResource, ok := value.(Resource); ok {
+		return copyEmbedded(embeddedResource, paths, selecting)
+	}
+
+	if embeddedResourceList, ok := value.([]Resource); ok {
+		copied := make([]Resource, len(embeddedResourceList))
+		for i, embeddedResource := range embeddedResourceList {
+			copied[i] = copyEmbedded(embeddedResource, paths, selecting)
+		}
+		return copied
+	}
+
+	return value
+}
+
+type Loader[K comparable] struct {
+	batch   func(ctx context.Context, keys []K) (map[K]Resource, error)
+	mutex   sync.Mutex
+	current *loaderBatch[K]
+}
+
+type loaderBatch[K comparable] struct {
+	keys    []K
+	started bool
+	once    sync.Once
+	results map[K]Resource
+	err     error
+}
+
+func NewLoader[K comparable](batch func(ctx context.Context, keys []K) (map[K]Resource, error)) *Loader[K] {
+	return &Loader[K]{batch: batch}
+}
+
+func (l *Loader[K]) Load(key K) func(ctx context.Context) (Resource, error) {
+	l.mutex.Lock()
+	if l.current == nil || l.current.started {
+		l.current = &loaderBatch[K]{}
+	}
+	b := l.current
+	if !slices.Contains(b.keys, key) {
+		b.keys = append(b.keys, key)
+	}
+	l.mutex.Unlock()
+
+	return func(ctx context.Context) (Resource, error) {
+		b.once.Do(func() {
+			l.mutex.Lock()
+			b.started = true
+			l.mutex.Unlock()
+
+			b.results, b.err = l.batch(ctx, b.keys)
+		})
+
+		if b.err != nil {
+			return NewResource(), b.err
+		}
+
+		r, ok := b.results[key]
+		if !ok {
+			return NewResource(), ErrNotLoaded
+		}
+		return r, nil
+	}
+}
@@ -0,0 +1,246 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newResolveTestUser(username string) Resource {
+	r := NewResource("User")
+	r.Data("Username", username)
+	return r
+}
+
+func Test_ResolveEmbeddedMustEmbedResolvedResources(t *testing.T) {
+	//arrange
+	r := NewResource("Account")
+	r.EmbedFunc("owner", func(context.Context) (Resource, error) {
+		return newResolveTestUser("ajones"), nil
+	})
+	r.EmbedResourcesFunc("members", func(context.Context) ([]Resource, error) {
+		return []Resource{newResolveTestUser("sanderson")}, nil
+	})
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 2)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Embedded["owner"].(Resource).Values["Username"])
+	a.Equal("sanderson", r.Embedded["members"].([]Resource)[0].Values["Username"])
+}
+
+func Test_ResolveEmbeddedMustResolveNestedFuncs(t *testing.T) {
+	//arrange
+	r := NewResource("Account")
+	r.EmbedFunc("owner", func(context.Context) (Resource, error) {
+		owner := newResolveTestUser("ajones")
+		owner.EmbedFunc("manager", func(context.Context) (Resource, error) {
+			return newResolveTestUser("sanderson"), nil
+		})
+		return owner, nil
+	})
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 2)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	manager := r.Embedded["owner"].(Resource).Embedded["manager"].(Resource)
+	a.Equal("sanderson", manager.Values["Username"])
+}
+
+func Test_ResolveEmbeddedMustLimitConcurrency(t *testing.T) {
+	//arrange
+	var running, maxRunning int32
+	users := make([]Resource, 10)
+	for i := range users {
+		users[i] = newResolveTestUser("user")
+		users[i].EmbedFunc("manager", func(context.Context) (Resource, error) {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return newResolveTestUser("manager"), nil
+		})
+	}
+	r := NewResource("UserList")
+	r.EmbedResources("users", users)
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 3)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.LessOrEqual(maxRunning, int32(3))
+	a.Greater(maxRunning, int32(1))
+}
+
+func Test_ResolveEmbeddedMustCollectErrorsPerRelation(t *testing.T) {
+	//arrange
+	failure := errors.New("service unavailable")
+	owner := newResolveTestUser("ajones")
+	owner.EmbedFunc("manager", func(context.Context) (Resource, error) {
+		return NewResource(), failure
+	})
+	r := NewResource("Account")
+	r.EmbedResource("owner", owner)
+	r.EmbedFunc("auditor", func(context.Context) (Resource, error) {
+		return newResolveTestUser("mwilliams"), nil
+	})
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 2)
+
+	//assert
+	a := assert.New(t)
+	var embedErrors EmbedErrors
+	a.ErrorAs(err, &embedErrors)
+	a.Len(embedErrors, 1)
+	a.Equal("owner.manager", embedErrors[0].Relation)
+	a.ErrorIs(embedErrors[0], failure)
+	a.EqualError(err, "unable to embed 'owner.manager': service unavailable")
+	_, ok := r.Embedded["auditor"].(Resource)
+	a.True(ok)
+}
+
+func Test_ResolveEmbeddedMustStopWhenContextIsCancelled(t *testing.T) {
+	//arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	r := NewResource("Account")
+	r.EmbedFunc("owner", func(context.Context) (Resource, error) {
+		called = true
+		return newResolveTestUser("ajones"), nil
+	})
+
+	//act
+	err := r.ResolveEmbedded(ctx, 2)
+
+	//assert
+	a := assert.New(t)
+	a.ErrorIs(err.(EmbedErrors)[0], context.Canceled)
+	a.False(called)
+}
+
+func Test_LoaderMustCombineKeysIntoOneBatch(t *testing.T) {
+	//arrange
+	var mutex sync.Mutex
+	batches := make([][]int, 0)
+	loader := NewLoader(func(ctx context.Context, keys []int) (map[int]Resource, error) {
+		mutex.Lock()
+		batches = append(batches, keys)
+		mutex.Unlock()
+
+		results := make(map[int]Resource)
+		for _, key := range keys {
+			results[key] = newResolveTestUser("manager")
+		}
+		return results, nil
+	})
+
+	users := make([]Resource, 5)
+	for i := range users {
+		users[i] = newResolveTestUser("user")
+		users[i].EmbedFunc("manager", loader.Load(i%3))
+	}
+	r := NewResource("UserList")
+	r.EmbedResources("users", users)
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 4)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Len(batches, 1)
+	a.ElementsMatch([]int{0, 1, 2}, batches[0])
+	for _, user := range r.Embedded["users"].([]Resource) {
+		a.Equal("manager", user.Embedded["manager"].(Resource).Values["Username"])
+	}
+}
+
+func Test_LoaderMustReturnErrorForMissingKey(t *testing.T) {
+	//arrange
+	loader := NewLoader(func(ctx context.Context, keys []int) (map[int]Resource, error) {
+		return map[int]Resource{}, nil
+	})
+
+	//act
+	_, err := loader.Load(1)(context.Background())
+
+	//assert
+	a := assert.New(t)
+	a.ErrorIs(err, ErrNotLoaded)
+}
+
+func Test_ResolveEmbeddedMustNotChangeOriginalResource(t *testing.T) {
+	//arrange
+	original := NewResource("Account")
+	original.EmbedFunc("owner", func(context.Context) (Resource, error) {
+		return newResolveTestUser("ajones"), nil
+	})
+	r := original
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 2)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Embedded["owner"].(Resource).Values["Username"])
+	_, resolved := original.Embedded["owner"].(Resource)
+	a.False(resolved)
+}
+
+func Test_ResolveEmbeddedMustResolveSelectedOnRequestRelations(t *testing.T) {
+	//arrange
+	r := NewResource("Account")
+	r.EmbedResourceOnRequest("owner", "/owner", func(context.Context) (Resource, error) {
+		return newResolveTestUser("ajones"), nil
+	})
+	r.EmbedResourceOnRequest("auditor", "/auditor", func(context.Context) (Resource, error) {
+		return newResolveTestUser("mwilliams"), nil
+	})
+
+	//act
+	err := r.SelectEmbedded([]string{"owner"}).ResolveEmbedded(context.Background(), 1)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("ajones", r.Embedded["owner"].(Resource).Values["Username"])
+	a.NotContains(r.Embedded, "auditor")
+}
+
+func Test_ResolveEmbeddedMustNotResolveOnRequestRelationsUnlessSelected(t *testing.T) {
+	//arrange
+	called := false
+	r := NewResource("Account")
+	r.EmbedResourceOnRequest("owner", "/owner", func(context.Context) (Resource, error) {
+		called = true
+		return newResolveTestUser("ajones"), nil
+	})
+
+	//act
+	err := r.ResolveEmbedded(context.Background(), 1)
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.False(called)
+}
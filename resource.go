@@ -31,10 +31,14 @@ type Link struct {
 	Parameters    []LinkParameter
 	Schema        string
 	ResponseCodes []int
+	Title         string
+	Type          string
+	Permissions   []string
+	Disabled      bool
 }
 
 func newLink(href string) Link {
-	return Link{href, "GET", false, make([]LinkParameter, 0), "", []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError}}
+	return Link{href, "GET", false, make([]LinkParameter, 0), "", []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError}, "", "", make([]string, 0), false}
 }
 
 type LinkParameter struct {
@@ -42,10 +46,19 @@ type LinkParameter struct {
 	DefaultValue string
 	ListOfValues string
 	DataType     string
+	Required     bool
+	Description  string
+	Min          string
+	Max          string
+	MinLength    int
+	MaxLength    int
+	Pattern      string
+	Multiple     bool
+	Format       string
 }
 
 func newLinkParameter(name string) LinkParameter {
-	return LinkParameter{name, "", "", ""}
+	return LinkParameter{name, "", "", "", false, "", "", "", 0, 0, "", false, ""}
 }
 
 type ResponseCode struct {
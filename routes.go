@@ -0,0 +1,140 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type RouteRegistry struct {
+	routes Resource
+}
+
+func NewRouteRegistry() *RouteRegistry {
+	return &RouteRegistry{NewResource()}
+}
+
+var DefaultRouteRegistry = NewRouteRegistry()
+
+func (rr *RouteRegistry) Route(name string, uriTemplate string, linkOptions ...option.Option) ConfigureLink {
+	return rr.routes.Link(name, uriTemplate, linkOptions...)
+}
+
+func (rr *RouteRegistry) Find(name string) (Link, bool) {
+	route, ok := rr.routes.Links[name]
+	if !ok {
+		return Link{}, false
+	}
+	return *route, true
+}
+
+func (rr *RouteRegistry) Names() []string {
+	names := make([]string, 0, len(rr.routes.Links))
+	for name := range rr.routes.Links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (rr *RouteRegistry) Resource() Resource {
+	return rr.routes
+}
+
+func (rr *RouteRegistry) Link(r *Resource, name string, parameters ...interface{}) ConfigureLink {
+	configureLink, ok := rr.FindLink(r, name, parameters...)
+	if !ok {
+		panic("route '" + name + "' is not registered")
+	}
+
+	return configureLink
+}
+
+func (rr *RouteRegistry) FindLink(r *Resource, name string, parameters ...interface{}) (ConfigureLink, bool) {
+	route, ok := rr.routes.Links[name]
+	if !ok {
+		return ConfigureLink{r, &Link{}}, false
+	}
+
+	return linkFromTemplate(r, name, *route, parameters...), true
+}
+
+func linkFromTemplate(r *Resource, name string, template Link, parameters ...interface{}) ConfigureLink {
+	href := ConstructUriFromTemplate(template.Href, parameters...)
+	linkOptions := []option.Option{option.Verb(template.Verb)}
+
+	re := regexp.MustCompile("{[a-zA-Z0-9]*}")
+	if template.IsTemplated || re.MatchString(href) {
+		linkOptions = append(linkOptions, option.Templated())
+	}
+
+	if template.Title != "" {
+		linkOptions = append(linkOptions, option.Title(template.Title))
+	}
+
+	if template.Type != "" {
+		linkOptions = append(linkOptions, option.MediaType(template.Type))
+	}
+
+	configureLink := r.Link(name, href, linkOptions...)
+	configureLink.link.Parameters = append(configureLink.link.Parameters, template.Parameters...)
+	configureLink.link.ResponseCodes = append([]int{}, template.ResponseCodes...)
+	configureLink.link.Schema = template.Schema
+	configureLink.link.Permissions = append([]string{}, template.Permissions...)
+
+	return configureLink
+}
+
+func (rr *RouteRegistry) Pattern(name string) string {
+	pattern, ok := rr.FindPattern(name)
+	if !ok {
+		panic("route '" + name + "' is not registered")
+	}
+
+	return pattern
+}
+
+func (rr *RouteRegistry) FindPattern(name string) (string, bool) {
+	route, ok := rr.routes.Links[name]
+	if !ok {
+		return "", false
+	}
+
+	path, _, _ := strings.Cut(route.Href, "?")
+	return route.Verb + " " + path, true
+}
+
+func (rr *RouteRegistry) FindUri(name string, parameters ...interface{}) (string, bool) {
+	route, ok := rr.routes.Links[name]
+	if !ok {
+		return "", false
+	}
+
+	return ConstructUriFromTemplate(route.Href, parameters...), true
+}
+
+func (rr *RouteRegistry) Handle(mux *http.ServeMux, name string, handler http.Handler) {
+	mux.Handle(rr.Pattern(name), handler)
+}
+
+func (rr *RouteRegistry) HandleFunc(mux *http.ServeMux, name string, handler func(http.ResponseWriter, *http.Request)) {
+	mux.HandleFunc(rr.Pattern(name), handler)
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) LinkTo(name string, parameters ...interface{}) ConfigureLink {
+	configureLink, _ := DefaultRouteRegistry.FindLink(r, name, parameters...)
+	return configureLink
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (r *Resource) UriTo(routeName string, parameters ...interface{}) *Resource {
+	uri, ok := DefaultRouteRegistry.FindUri(routeName, parameters...)
+	if !ok {
+		return r
+	}
+
+	return r.Uri(uri)
+}
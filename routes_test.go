@@ -0,0 +1,194 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestRouteRegistry() *RouteRegistry {
+	rr := NewRouteRegistry()
+	rr.Route("getUser", "/user/{Id}").
+		Schema("User")
+	rr.Route("updateUser", "/user/{Id}", option.Verb("PUT")).
+		Parameter("username").
+		Parameter("email").
+		Schema("User").
+		ResponseCodes(http.StatusOK, http.StatusNotFound)
+	return rr
+}
+
+func Test_RouteRegistryLinkMustBuildLinkFromRoute(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	r := NewResource("User")
+
+	//act
+	rr.Link(&r, "updateUser", 5)
+
+	//assert
+	a := assert.New(t)
+	link := r.Links["updateUser"]
+	a.Equal("/user/5", link.Href)
+	a.Equal("PUT", link.Verb)
+	a.False(link.IsTemplated)
+	a.Equal("User", link.Schema)
+	a.Equal([]int{http.StatusOK, http.StatusNotFound}, link.ResponseCodes)
+	a.Equal([]LinkParameter{{Name: "username"}, {Name: "email"}}, link.Parameters)
+}
+
+func Test_RouteRegistryLinkMustNotShareParametersWithRoute(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	r := NewResource("User")
+
+	//act
+	rr.Link(&r, "updateUser", 5).Parameter("isActive")
+
+	//assert
+	a := assert.New(t)
+	route, _ := rr.Find("updateUser")
+	a.Len(route.Parameters, 2)
+}
+
+func Test_RouteRegistryLinkMustBeTemplatedWithoutParameters(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	r := NewResource("Application")
+
+	//act
+	rr.Link(&r, "getUser")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("/user/{Id}", r.Links["getUser"].Href)
+	a.True(r.Links["getUser"].IsTemplated)
+}
+
+func Test_RouteRegistryLinkMustPanicForUnknownRoute(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	r := NewResource()
+
+	//act
+	//assert
+	a := assert.New(t)
+	a.Panics(func() { rr.Link(&r, "missing") })
+}
+
+func Test_RouteRegistryFindLinkMustNotAddLinkForUnknownRoute(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	r := NewResource()
+
+	//act
+	_, ok := rr.FindLink(&r, "missing")
+
+	//assert
+	a := assert.New(t)
+	a.False(ok)
+	a.NotContains(r.Links, "missing")
+}
+
+func Test_RouteRegistryFindPatternMustReturnFalseForUnknownRoute(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+
+	//act
+	_, ok := rr.FindPattern("missing")
+
+	//assert
+	assert.False(t, ok)
+}
+
+func Test_RouteRegistryMustReturnSortedNames(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+
+	//act
+	names := rr.Names()
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]string{"getUser", "updateUser"}, names)
+}
+
+func Test_RouteRegistryPatternMustIncludeVerbAndPath(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	rr.Route("searchUsers", "/user?username={username}")
+
+	//act
+	pattern := rr.Pattern("searchUsers")
+
+	//assert
+	a := assert.New(t)
+	a.Equal("GET /user", pattern)
+}
+
+func Test_RouteRegistryHandleFuncMustRegisterOnServeMux(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+	mux := http.NewServeMux()
+	rr.HandleFunc(mux, "getUser", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("get " + req.PathValue("Id")))
+	})
+	rr.HandleFunc(mux, "updateUser", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("update " + req.PathValue("Id")))
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/user/7", nil)
+
+	//act
+	mux.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("update 7", w.Body.String())
+}
+
+func Test_RouteRegistryResourceMustContainRoutesAsLinks(t *testing.T) {
+	//arrange
+	rr := newTestRouteRegistry()
+
+	//act
+	r := rr.Resource()
+
+	//assert
+	a := assert.New(t)
+	a.Len(r.Links, 2)
+	a.Equal("PUT", r.Links["updateUser"].Verb)
+}
+
+func Test_LinkToMustUseDefaultRouteRegistry(t *testing.T) {
+	//arrange
+	DefaultRouteRegistry.Route("testLinkTo", "/test/{Id}", option.Verb("DELETE"))
+	r := NewResource("Test")
+
+	//act
+	r.UriTo("testLinkTo", 3)
+	r.LinkTo("testLinkTo", 3)
+
+	//assert
+	a := assert.New(t)
+	a.Equal("/test/3", r.Links["self"].Href)
+	a.Equal("Test", r.Links["self"].Schema)
+	a.Equal("/test/3", r.Links["testLinkTo"].Href)
+	a.Equal("DELETE", r.Links["testLinkTo"].Verb)
+}
+
+func Test_LinkToMustNotPanicForUnknownRoute(t *testing.T) {
+	//arrange
+	r := NewResource("Test")
+
+	//act
+	r.UriTo("testMissing", 3)
+	r.LinkTo("testMissing", 3).Parameter("name")
+
+	//assert
+	a := assert.New(t)
+	a.Empty(r.Links)
+}
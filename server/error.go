@@ -0,0 +1,48 @@
+package server
+
+import (
+	"errors"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"net/http"
+)
+
+type Error struct {
+	Status int
+	Detail string
+}
+
+func NewError(status int, detail string) *Error {
+	return &Error{status, detail}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func (e *Error) StatusCode() int {
+	return e.Status
+}
+
+func Problem(err error, instance string, link ...resource.Link) (resource.Resource, int) {
+	var validationErrors encoding.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return encoding.NewValidationProblem(validationErrors, link...).Instance(instance).Resource(), http.StatusBadRequest
+	}
+
+	var statusCoder interface{ StatusCode() int }
+	if errors.As(err, &statusCoder) {
+		status := statusCoder.StatusCode()
+		return encoding.NewProblem(status).Detail(err.Error()).Instance(instance).Resource(), status
+	}
+
+	status := http.StatusInternalServerError
+	return encoding.NewProblem(status).Instance(instance).Resource(), status
+}
+
+func (s *Server) writeError(w http.ResponseWriter, req *http.Request, err error, link ...resource.Link) {
+	problem, status := Problem(err, req.URL.Path, link...)
+	if err := encoding.Write(w, req, status, problem); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/internal/openapi"
+	"net/http"
+)
+
+type Info = openapi.Info
+
+func (s *Server) Document(resources ...resource.Resource) *Server {
+	s.documented = append(s.documented, resources...)
+	return s
+}
+
+func (s *Server) OpenApi(headers map[string][]string, info Info, serverUrl string) ([]byte, string) {
+	resources := append([]resource.Resource{s.routes.Resource()}, s.documented...)
+	return openapi.MarshalDoc(headers, info, serverUrl, resources...)
+}
+
+func (s *Server) ServeOpenApi(pattern string, info Info, serverUrl string) {
+	s.mux.HandleFunc("GET "+pattern, func(w http.ResponseWriter, req *http.Request) {
+		doc, contentType := s.OpenApi(req.Header, info, serverUrl)
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(doc)
+	})
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/encoding"
+	"github.com/slyjeff/rest-resource/option"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+type Request struct {
+	*http.Request
+	Values map[string]interface{}
+}
+
+type Handler func(req *Request) (resource.Resource, int, error)
+
+type Server struct {
+	mux          *http.ServeMux
+	routes       *resource.RouteRegistry
+	writeOptions []option.Option
+	documented   []resource.Resource
+}
+
+func New(routes *resource.RouteRegistry, writeOptions ...option.Option) *Server {
+	return &Server{http.NewServeMux(), routes, writeOptions, make([]resource.Resource, 0)}
+}
+
+func (s *Server) Endpoint(name string, uriTemplate string, handler Handler, linkOptions ...option.Option) resource.ConfigureLink {
+	configureLink := s.routes.Route(name, uriTemplate, linkOptions...)
+	s.mux.Handle(muxPattern(s.routes.Pattern(name)), s.endpointHandler(name, handler))
+	return configureLink
+}
+
+func (s *Server) Routes() *resource.RouteRegistry {
+	return s.routes
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if _, pattern := s.mux.Handler(req); pattern != "" {
+		s.mux.ServeHTTP(w, req)
+		return
+	}
+
+	if allowed := s.allowedMethods(req); len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		s.writeError(w, req, NewError(http.StatusMethodNotAllowed, "Method "+req.Method+" is not allowed for "+req.URL.Path+"."))
+		return
+	}
+
+	s.writeError(w, req, NewError(http.StatusNotFound, "No resource found at "+req.URL.Path+"."))
+}
+
+func (s *Server) endpointHandler(name string, handler Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		link, _ := s.routes.Find(name)
+
+		values, err := encoding.Validate(link, req)
+		if err != nil {
+			s.writeError(w, req, err, link)
+			return
+		}
+
+		r, status, err := handler(&Request{req, values})
+		if err != nil {
+			s.writeError(w, req, err, link)
+			return
+		}
+
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		headers := w.Header().Clone()
+		cw := &commitWriter{ResponseWriter: w}
+		if err := encoding.Write(cw, req, status, r, s.writeOptions...); err != nil && !cw.committed {
+			clear(w.Header())
+			for name, values := range headers {
+				w.Header()[name] = values
+			}
+			s.writeError(w, req, err, link)
+		}
+	}
+}
+
+type commitWriter struct {
+	http.ResponseWriter
+	committed bool
+}
+
+func (cw *commitWriter) WriteHeader(status int) {
+	cw.committed = true
+	cw.ResponseWriter.WriteHeader(status)
+}
+
+func (cw *commitWriter) Write(b []byte) (int, error) {
+	cw.committed = true
+	return cw.ResponseWriter.Write(b)
+}
+
+func (cw *commitWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func (s *Server) allowedMethods(req *http.Request) []string {
+	allowed := make([]string, 0)
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		if method == req.Method {
+			continue
+		}
+
+		probe := req.Clone(req.Context())
+		probe.Method = method
+		if _, pattern := s.mux.Handler(probe); pattern != "" && !slices.Contains(allowed, method) {
+			allowed = append(allowed, method)
+		}
+	}
+	return allowed
+}
+
+func muxPattern(pattern string) string {
+	if strings.HasSuffix(pattern, " /") {
+		return pattern + "{$}"
+	}
+	return pattern
+}
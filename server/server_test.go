@@ -0,0 +1,235 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/slyjeff/rest-resource"
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestServer() *Server {
+	s := New(resource.NewRouteRegistry())
+
+	s.Endpoint("getUser", "/user/{Id}", func(req *Request) (resource.Resource, int, error) {
+		if req.PathValue("Id") != "1" {
+			return resource.Resource{}, 0, NewError(http.StatusNotFound, "User not found.")
+		}
+
+		r := resource.NewResource("User")
+		r.Uri("/user/1")
+		r.Data("username", "ajones")
+		return r, http.StatusOK, nil
+	}).Schema("User")
+
+	s.Endpoint("createUser", "/user", func(req *Request) (resource.Resource, int, error) {
+		r := resource.NewResource("User")
+		r.Uri("/user/2")
+		r.Data("username", req.Values["username"])
+		r.Data("age", req.Values["age"])
+		return r, http.StatusCreated, nil
+	}, option.Verb("POST")).
+		Parameter("username", option.Required()).
+		Parameter("age", option.DataType("int"), option.Default("30")).
+		Schema("User")
+
+	s.Endpoint("deleteUser", "/user/{Id}", func(req *Request) (resource.Resource, int, error) {
+		return resource.Resource{}, 0, fmt.Errorf("database unavailable")
+	}, option.Verb("DELETE"))
+
+	return s
+}
+
+func Test_ServerMustServeEndpoint(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/user/1", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	a.Equal("application/json", w.Header().Get("Content-Type"))
+	a.Equal(`{"username":"ajones","_links":{"self":{"href":"/user/1"}}}`, w.Body.String())
+}
+
+func Test_ServerMustDefaultMissingStatusToOk(t *testing.T) {
+	//arrange
+	s := New(resource.NewRouteRegistry())
+	s.Endpoint("getStatus", "/status", func(req *Request) (resource.Resource, int, error) {
+		return resource.NewResource("Status"), 0, nil
+	})
+	req := httptest.NewRequest(http.MethodGet, "/status", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_ServerMustNotWriteProblemAfterResponseIsCommitted(t *testing.T) {
+	//arrange
+	s := New(resource.NewRouteRegistry())
+	s.Endpoint("getReport", "/report", func(req *Request) (resource.Resource, int, error) {
+		r := resource.NewResource("Report")
+		r.Data("broken", func() {})
+		return r, http.StatusOK, nil
+	})
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	//act
+	response, err := http.Get(server.URL + "/report")
+
+	//assert
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal(http.StatusOK, response.StatusCode)
+	a.Equal("application/json", response.Header.Get("Content-Type"))
+}
+
+func Test_ServerMustWriteProblemIfResponseIsNotCommitted(t *testing.T) {
+	//arrange
+	s := New(resource.NewRouteRegistry())
+	s.Endpoint("getReport", "/report", func(req *Request) (resource.Resource, int, error) {
+		r := resource.NewResource("Report")
+		r.Data("broken", func() {})
+		return r, http.StatusOK, nil
+	})
+	req := httptest.NewRequest(http.MethodHead, "/report", nil)
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusInternalServerError, w.Code)
+	a.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	a.Empty(w.Header().Get("Allow"))
+}
+
+func Test_ServerMustPassValidatedValuesToHandler(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader("username=bsmith"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusCreated, w.Code)
+	a.Equal("/user/2", w.Header().Get("Location"))
+	a.Contains(w.Body.String(), `"age":30`)
+	a.Contains(w.Body.String(), `"username":"bsmith"`)
+}
+
+func Test_ServerMustReturnValidationProblem(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	req := httptest.NewRequest(http.MethodPost, "/user", strings.NewReader(`{"age":"old"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusBadRequest, w.Code)
+	a.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	a.Contains(w.Body.String(), `"invalid-params":[{"name":"username","reason":"is required"},{"name":"age","reason":"must be of type int"}]`)
+}
+
+func Test_ServerMustMapErrorsToProblems(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	notFound := httptest.NewRecorder()
+	internalError := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(notFound, httptest.NewRequest(http.MethodGet, "/user/5", nil))
+	s.ServeHTTP(internalError, httptest.NewRequest(http.MethodDelete, "/user/5", nil))
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusNotFound, notFound.Code)
+	a.Equal(`{"detail":"User not found.","instance":"/user/5","status":404,"title":"Not Found","type":"about:blank"}`, notFound.Body.String())
+	a.Equal(http.StatusInternalServerError, internalError.Code)
+	a.NotContains(internalError.Body.String(), "database unavailable")
+}
+
+func Test_ServerMustReturnProblemsForUnknownRoutes(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	notFound := httptest.NewRecorder()
+	notAllowed := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(notFound, httptest.NewRequest(http.MethodGet, "/order/1", nil))
+	s.ServeHTTP(notAllowed, httptest.NewRequest(http.MethodPut, "/user/1", nil))
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusNotFound, notFound.Code)
+	a.Equal("application/problem+json", notFound.Header().Get("Content-Type"))
+	a.Equal(http.StatusMethodNotAllowed, notAllowed.Code)
+	a.Equal("GET, HEAD, DELETE", notAllowed.Header().Get("Allow"))
+}
+
+func Test_ServerMustRegisterEndpointsAsRoutes(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	r := resource.NewResource("User")
+
+	//act
+	s.Routes().Link(&r, "createUser")
+
+	//assert
+	a := assert.New(t)
+	a.Equal([]string{"createUser", "deleteUser", "getUser"}, s.Routes().Names())
+	a.Equal("POST", r.Links["createUser"].Verb)
+	a.Len(r.Links["createUser"].Parameters, 2)
+}
+
+func Test_ServerMustServeOpenApiForEndpoints(t *testing.T) {
+	//arrange
+	s := newTestServer()
+	user := resource.NewResource("User")
+	user.Data("username", "")
+	s.Document(user)
+	s.ServeOpenApi("/openapi", Info{Title: "Test", Version: "1.0"}, "http://localhost/")
+	req := httptest.NewRequest(http.MethodGet, "/openapi", nil)
+	req.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+
+	//act
+	s.ServeHTTP(w, req)
+
+	//assert
+	a := assert.New(t)
+	a.Equal(http.StatusOK, w.Code)
+	var doc struct {
+		Paths      map[string]map[string]interface{}
+		Components struct{ Schemas map[string]interface{} }
+	}
+	a.NoError(json.Unmarshal(w.Body.Bytes(), &doc))
+	a.Contains(doc.Paths, "/user")
+	a.Contains(doc.Paths["/user"], "post")
+	a.Contains(doc.Paths["/user/{Id}"], "get")
+	a.Contains(doc.Paths["/user/{Id}"], "delete")
+	a.Contains(doc.Components.Schemas, "User")
+	a.Contains(doc.Components.Schemas, "POSTUser")
+}
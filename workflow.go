@@ -0,0 +1,162 @@
+package resource
+
+import (
+	"fmt"
+	"github.com/slyjeff/rest-resource/option"
+	"slices"
+	"strings"
+)
+
+type Guard func(r Resource) bool
+
+type Workflow struct {
+	schema      string
+	stateField  string
+	states      []string
+	transitions []*transition
+	links       Resource
+}
+
+type transition struct {
+	name   string
+	from   []string
+	to     string
+	guards []Guard
+}
+
+func NewWorkflow(schema string, stateField string, states ...string) *Workflow {
+	return &Workflow{schema, stateField, states, make([]*transition, 0), NewResource(schema)}
+}
+
+func (w *Workflow) Transition(name string, uriTemplate string, linkOptions ...option.Option) ConfigureTransition {
+	if _, ok := w.links.Links[name]; ok {
+		panic("transition '" + name + "' is already defined")
+	}
+
+	t := &transition{name, make([]string, 0), "", make([]Guard, 0)}
+	w.transitions = append(w.transitions, t)
+
+	return ConfigureTransition{t, w.links.Link(name, uriTemplate, linkOptions...)}
+}
+
+func (w *Workflow) State(r Resource) string {
+	value, ok := r.Values[w.stateField]
+	if !ok || value == nil {
+		return ""
+	}
+
+	if formattedData, ok := value.(FormattedData); ok {
+		value = formattedData.Value
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func (w *Workflow) Allowed(r Resource) []string {
+	state := w.State(r)
+
+	allowed := make([]string, 0)
+	for _, t := range w.transitions {
+		if t.isAllowed(state, r) {
+			allowed = append(allowed, t.name)
+		}
+	}
+	return allowed
+}
+
+//goland:noinspection GoMixedReceiverTypes
+func (w *Workflow) Apply(r *Resource, parameters ...interface{}) *Resource {
+	for _, name := range w.Allowed(*r) {
+		linkFromTemplate(r, name, *w.links.Links[name], parameters...)
+	}
+	return r
+}
+
+func (w *Workflow) Resource() Resource {
+	r := NewResource(w.schema)
+	r.Data(w.stateField, "")
+	for name, link := range w.links.Links {
+		linkCopy := *link
+		r.Links[name] = &linkCopy
+	}
+	return r
+}
+
+func (w *Workflow) Diagram() string {
+	sb := strings.Builder{}
+	sb.WriteString("stateDiagram-v2\n")
+
+	if len(w.states) > 0 {
+		sb.WriteString(fmt.Sprintf("    [*] --> %s\n", w.states[0]))
+	}
+
+	for _, t := range w.transitions {
+		if t.to == "" {
+			continue
+		}
+
+		from := t.from
+		if len(from) == 0 {
+			from = w.states
+		}
+
+		for _, state := range from {
+			sb.WriteString(fmt.Sprintf("    %s --> %s: %s\n", state, t.to, t.name))
+		}
+	}
+
+	return sb.String()
+}
+
+func (t *transition) isAllowed(state string, r Resource) bool {
+	if len(t.from) > 0 && !slices.Contains(t.from, state) {
+		return false
+	}
+
+	for _, guard := range t.guards {
+		if !guard(r) {
+			return false
+		}
+	}
+	return true
+}
+
+type ConfigureTransition struct {
+	transition    *transition
+	configureLink ConfigureLink
+}
+
+func (ct ConfigureTransition) From(states ...string) ConfigureTransition {
+	ct.transition.from = append(ct.transition.from, states...)
+	return ct
+}
+
+func (ct ConfigureTransition) To(state string) ConfigureTransition {
+	ct.transition.to = state
+	return ct
+}
+
+func (ct ConfigureTransition) When(guard Guard) ConfigureTransition {
+	ct.transition.guards = append(ct.transition.guards, guard)
+	return ct
+}
+
+func (ct ConfigureTransition) Parameter(name string, parameterOptions ...option.Option) ConfigureTransition {
+	ct.configureLink.Parameter(name, parameterOptions...)
+	return ct
+}
+
+func (ct ConfigureTransition) Schema(schema string) ConfigureTransition {
+	ct.configureLink.Schema(schema)
+	return ct
+}
+
+func (ct ConfigureTransition) Requires(permissions ...string) ConfigureTransition {
+	ct.configureLink.Requires(permissions...)
+	return ct
+}
+
+func (ct ConfigureTransition) ResponseCodes(statuses ...int) ConfigureTransition {
+	ct.configureLink.ResponseCodes(statuses...)
+	return ct
+}
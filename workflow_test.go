@@ -0,0 +1,110 @@
+package resource
+
+import (
+	"github.com/slyjeff/rest-resource/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestOrderWorkflow() *Workflow {
+	w := NewWorkflow("Order", "status", "pending", "approved", "shipped", "cancelled")
+	w.Transition("approveOrder", "/order/{id}/approval", option.Verb("POST")).
+		From("pending").
+		To("approved").
+		Parameter("comment")
+	w.Transition("shipOrder", "/order/{id}/shipment", option.Verb("POST")).
+		From("approved").
+		To("shipped").
+		When(func(r Resource) bool { return r.Values["paid"] == true })
+	w.Transition("cancelOrder", "/order/{id}", option.Verb("DELETE")).
+		From("pending", "approved").
+		To("cancelled")
+	return w
+}
+
+func newTestOrder(status string, paid bool) Resource {
+	r := NewResource("Order")
+	r.Data("status", status)
+	r.Data("paid", paid)
+	return r
+}
+
+func Test_WorkflowApplyMustAddLinksForTransitionsFromCurrentState(t *testing.T) {
+	//arrange
+	w := newTestOrderWorkflow()
+	r := newTestOrder("pending", false)
+
+	//act
+	w.Apply(&r, 7)
+
+	//assert
+	a := assert.New(t)
+	a.Len(r.Links, 2)
+	a.Equal("/order/7/approval", r.Links["approveOrder"].Href)
+	a.Equal("POST", r.Links["approveOrder"].Verb)
+	a.False(r.Links["approveOrder"].IsTemplated)
+	a.Equal([]LinkParameter{{Name: "comment"}}, r.Links["approveOrder"].Parameters)
+	a.Equal("/order/7", r.Links["cancelOrder"].Href)
+	a.Equal("DELETE", r.Links["cancelOrder"].Verb)
+}
+
+func Test_WorkflowApplyMustNotAddLinksWhenGuardFails(t *testing.T) {
+	//arrange
+	w := newTestOrderWorkflow()
+	unpaid := newTestOrder("approved", false)
+	paid := newTestOrder("approved", true)
+
+	//act
+	w.Apply(&unpaid, 7)
+	w.Apply(&paid, 7)
+
+	//assert
+	a := assert.New(t)
+	a.NotContains(unpaid.Links, "shipOrder")
+	a.Contains(unpaid.Links, "cancelOrder")
+	a.Contains(paid.Links, "shipOrder")
+}
+
+func Test_WorkflowApplyMustNotAddLinksInFinalState(t *testing.T) {
+	//arrange
+	w := newTestOrderWorkflow()
+	r := newTestOrder("shipped", true)
+
+	//act
+	w.Apply(&r, 7)
+
+	//assert
+	assert.Empty(t, r.Links)
+}
+
+func Test_WorkflowDiagramMustIncludeAllTransitions(t *testing.T) {
+	//arrange
+	w := newTestOrderWorkflow()
+
+	//act
+	diagram := w.Diagram()
+
+	//assert
+	expected := "stateDiagram-v2\n" +
+		"    [*] --> pending\n" +
+		"    pending --> approved: approveOrder\n" +
+		"    approved --> shipped: shipOrder\n" +
+		"    pending --> cancelled: cancelOrder\n" +
+		"    approved --> cancelled: cancelOrder\n"
+	assert.Equal(t, expected, diagram)
+}
+
+func Test_WorkflowResourceMustIncludeEveryTransitionLink(t *testing.T) {
+	//arrange
+	w := newTestOrderWorkflow()
+
+	//act
+	r := w.Resource()
+
+	//assert
+	a := assert.New(t)
+	a.Equal("Order", r.Schema)
+	a.Contains(r.Values, "status")
+	a.Len(r.Links, 3)
+	a.Equal("/order/{id}/shipment", r.Links["shipOrder"].Href)
+}